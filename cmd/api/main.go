@@ -8,10 +8,15 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // event time zones must resolve on images without zoneinfo
 
 	"playspotter/internal/config"
 	"playspotter/internal/db"
 	"playspotter/internal/handlers"
+	"playspotter/internal/jobs"
+	"playspotter/internal/payments"
+	"playspotter/internal/push"
+	"playspotter/internal/realtime"
 	"playspotter/internal/repositories"
 	"playspotter/internal/routes"
 	"playspotter/internal/services"
@@ -70,6 +75,25 @@ func main() {
 	participantRepo := repositories.NewParticipantRepository(database)
 	swipeRepo := repositories.NewSwipeRepository(database)
 	tokenRepo := repositories.NewTokenRepository(database)
+	sportRepo := repositories.NewSportRepository(database)
+	venueRepo := repositories.NewVenueRepository(database)
+	cohostRepo := repositories.NewCoHostRepository(database)
+	skillRepo := repositories.NewSkillRepository(database)
+	teamRepo := repositories.NewTeamRepository(database)
+	tournamentRepo := repositories.NewTournamentRepository(database)
+	paymentRepo := repositories.NewPaymentRepository(database)
+	costRepo := repositories.NewCostRepository(database)
+	commentRepo := repositories.NewCommentRepository(database)
+	notificationRepo := repositories.NewNotificationRepository(database)
+	deviceRepo := repositories.NewDeviceRepository(database)
+	jobRepo := repositories.NewJobRepository(database)
+	templateRepo := repositories.NewTemplateRepository(database)
+	calendarFeedRepo := repositories.NewCalendarFeedRepository(database)
+	followRepo := repositories.NewFollowRepository(database)
+	blockRepo := repositories.NewBlockRepository(database)
+	reportRepo := repositories.NewReportRepository(database)
+	moderationRepo := repositories.NewModerationRepository(database)
+	auditRepo := repositories.NewAuditRepository(database)
 
 	// Initialize JWT manager
 	jwtManager := jwt.NewManager(
@@ -79,21 +103,109 @@ func main() {
 		cfg.RefreshTTL,
 	)
 
+	// Initialize payment provider; without one, paid events are rejected
+	var paymentProvider payments.PaymentProvider
+	var fakeProvider *payments.FakeProvider
+	switch cfg.PaymentProvider {
+	case "none":
+	case "fake":
+		fakeProvider = payments.NewFakeProvider(cfg.PaymentWebhookSecret)
+		paymentProvider = fakeProvider
+	default:
+		log.Fatalf("Unsupported PAYMENT_PROVIDER: %s", cfg.PaymentProvider)
+	}
+
+	// Initialize push sender
+	var pushSender push.PushSender
+	switch cfg.PushProvider {
+	case "fake":
+		pushSender = push.NewFakeSender()
+	case "fcm":
+		if cfg.PushFCMProjectID == "" || cfg.PushFCMAccessToken == "" {
+			log.Fatalf("PUSH_FCM_PROJECT_ID and PUSH_FCM_ACCESS_TOKEN are required for the fcm push provider")
+		}
+		pushSender = push.NewFCMSender(cfg.PushFCMEndpoint, cfg.PushFCMProjectID, cfg.PushFCMAccessToken)
+	default:
+		log.Fatalf("Unsupported PUSH_PROVIDER: %s", cfg.PushProvider)
+	}
+
+	// Realtime updates are relayed through Postgres so every replica's streams get them
+	hub := realtime.NewHub()
+	relay := realtime.NewPostgresRelay(database, cfg.DatabaseURL)
+	hub.UseRelay(relay)
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	go relay.Listen(relayCtx, hub)
+
 	// Initialize services
-	authService := services.NewAuthService(userRepo, tokenRepo, jwtManager)
-	userService := services.NewUserService(userRepo)
-	eventService := services.NewEventService(eventRepo, participantRepo)
+	auditService := services.NewAuditService(auditRepo)
+	authService := services.NewAuthService(userRepo, tokenRepo, jwtManager, auditService)
+	sportService := services.NewSportService(sportRepo)
+	userService := services.NewUserService(userRepo, skillRepo, sportService, auditService)
+	venueService := services.NewVenueService(venueRepo, sportService)
+	paymentService := services.NewPaymentService(paymentRepo, paymentProvider, cfg.PaymentTimeout)
+	pushService := services.NewPushService(deviceRepo, pushSender, push.RetryPolicy{
+		MaxAttempts: cfg.PushMaxAttempts,
+		BaseDelay:   cfg.PushRetryDelay,
+	})
+	notificationService := services.NewNotificationService(notificationRepo, pushService)
+	eventService := services.NewEventService(eventRepo, participantRepo, venueRepo, cohostRepo, userRepo, sportService, paymentService, notificationService, jobRepo, blockRepo, auditService, hub, cfg.CancellationWindow)
 	swipeService := services.NewSwipeService(swipeRepo)
+	teamService := services.NewTeamService(teamRepo, skillRepo, participantRepo, eventService)
+	tournamentService := services.NewTournamentService(tournamentRepo, participantRepo, eventService)
+	costService := services.NewCostService(costRepo, participantRepo, eventService, notificationService)
+	templateService := services.NewTemplateService(templateRepo, eventService)
+	calendarService := services.NewCalendarService(calendarFeedRepo, eventRepo, eventService)
+	followService := services.NewFollowService(followRepo, blockRepo, userRepo, notificationService)
+	blockService := services.NewBlockService(blockRepo, followRepo, userRepo)
+	reportService := services.NewReportService(reportRepo, userRepo, eventRepo, commentRepo)
+	profileService := services.NewProfileService(userRepo, skillRepo, eventRepo, participantRepo, followService)
+	commentService := services.NewCommentService(commentRepo, participantRepo, eventService,
+		services.NewBlockedWordsModerator(cfg.CommentBlockedWords))
+	accountService := services.NewAccountService(userRepo, tokenRepo, eventService, auditService)
+	moderationService := services.NewModerationService(reportRepo, moderationRepo, eventRepo, commentRepo,
+		eventService, commentService, accountService, notificationService)
 
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(authService)
 	meHandler := handlers.NewMeHandler(userService)
 	eventHandler := handlers.NewEventHandler(eventService, swipeService)
 	adminHandler := handlers.NewAdminHandler(userService, eventService)
+	sportHandler := handlers.NewSportHandler(sportService)
+	venueHandler := handlers.NewVenueHandler(venueService)
+	teamHandler := handlers.NewTeamHandler(teamService)
+	tournamentHandler := handlers.NewTournamentHandler(tournamentService)
+	paymentHandler := handlers.NewPaymentHandler(eventService, fakeProvider)
+	costHandler := handlers.NewCostHandler(costService)
+	commentHandler := handlers.NewCommentHandler(commentService)
+	realtimeHandler := handlers.NewRealtimeHandler(hub, eventService, commentService)
+	notificationHandler := handlers.NewNotificationHandler(notificationService, pushService)
+	templateHandler := handlers.NewTemplateHandler(templateService)
+	calendarHandler := handlers.NewCalendarHandler(calendarService, cfg.PublicURL)
+	userHandler := handlers.NewUserHandler(profileService, followService, blockService)
+	reportHandler := handlers.NewReportHandler(reportService)
+	moderationHandler := handlers.NewModerationHandler(moderationService)
+	auditHandler := handlers.NewAuditHandler(auditService)
+
+	// Background jobs
+	jobRunner := jobs.NewRunner(jobRepo, cfg.JobPollInterval, cfg.JobLease, cfg.JobRetention)
+	jobRunner.Handle(services.JobEventReminder, eventService.SendEventReminder)
+	jobRunner.Handle(services.JobEventPublish, eventService.PublishScheduledEvent)
+	jobRunner.Handle(services.JobEventRefund, eventService.RefundCancelledEvent)
+	jobRunner.Handle(services.JobPaymentRefund, eventService.RefundPaidPayment)
+	jobRunner.Every(services.JobEventLifecycle, cfg.EventLifecycleEvery, eventService.AdvanceLifecycle)
+	if cfg.JobsEnabled {
+		jobRunner.Start()
+	}
 
 	// Setup router
 	router := gin.Default()
 
+	// Client IPs (rate limits, audit log) come from X-Forwarded-For only when the
+	// request passed through a trusted proxy; by default the connection address is used
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
+
 	// Swagger documentation
 	router.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -103,7 +215,23 @@ func main() {
 		meHandler,
 		eventHandler,
 		adminHandler,
+		sportHandler,
+		venueHandler,
+		teamHandler,
+		tournamentHandler,
+		paymentHandler,
+		costHandler,
+		commentHandler,
+		realtimeHandler,
+		notificationHandler,
+		templateHandler,
+		calendarHandler,
+		userHandler,
+		reportHandler,
+		moderationHandler,
+		auditHandler,
 		jwtManager,
+		accountService.CheckAccount,
 		cfg,
 	)
 	apiRouter.Setup(router)
@@ -130,6 +258,10 @@ func main() {
 
 	log.Println("Shutting down server...")
 
+	// End open streams so Shutdown does not wait for them
+	stopRelay()
+	hub.Close()
+
 	// Graceful shutdown with 5 second timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -138,5 +270,13 @@ func main() {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
 
+	// Finish the running job; an interrupted one is retried after its lease expires
+	if err := jobRunner.Shutdown(ctx); err != nil {
+		log.Printf("Job runner stopped before its job finished: %v", err)
+	}
+
+	// Let pushes already being delivered finish
+	pushService.Wait()
+
 	log.Println("Server exited")
 }
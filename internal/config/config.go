@@ -4,23 +4,42 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Env                 string
-	Port                string
-	DatabaseURL         string
-	JWTAccessSecret     string
-	JWTRefreshSecret    string
-	AccessTTL           time.Duration
-	RefreshTTL          time.Duration
-	AdminBootstrapToken string
-	AdminEmail          string
-	AdminPassword       string
-	AllowedOrigins      []string
+	Env                  string
+	Port                 string
+	PublicURL            string
+	DatabaseURL          string
+	JWTAccessSecret      string
+	JWTRefreshSecret     string
+	AccessTTL            time.Duration
+	RefreshTTL           time.Duration
+	AdminBootstrapToken  string
+	AdminEmail           string
+	AdminPassword        string
+	AllowedOrigins       []string
+	TrustedProxies       []string
+	PaymentProvider      string
+	PaymentWebhookSecret string
+	PaymentTimeout       time.Duration
+	CommentBlockedWords  []string
+	PushProvider         string
+	PushFCMEndpoint      string
+	PushFCMProjectID     string
+	PushFCMAccessToken   string
+	PushMaxAttempts      int
+	PushRetryDelay       time.Duration
+	JobsEnabled          bool
+	JobPollInterval      time.Duration
+	JobLease             time.Duration
+	JobRetention         time.Duration
+	EventLifecycleEvery  time.Duration
+	CancellationWindow   time.Duration
 }
 
 func Load() (*Config, error) {
@@ -28,15 +47,24 @@ func Load() (*Config, error) {
 	_ = godotenv.Load()
 
 	cfg := &Config{
-		Env:                 getEnv("ENV", "development"),
-		Port:                getEnv("PORT", "8080"),
-		DatabaseURL:         getEnv("DATABASE_URL", ""),
-		JWTAccessSecret:     getEnv("JWT_ACCESS_SECRET", ""),
-		JWTRefreshSecret:    getEnv("JWT_REFRESH_SECRET", ""),
-		AdminBootstrapToken: getEnv("ADMIN_BOOTSTRAP_TOKEN", ""),
-		AdminEmail:          getEnv("ADMIN_EMAIL", "admin@example.com"),
-		AdminPassword:       getEnv("ADMIN_PASSWORD", ""),
-		AllowedOrigins:      getEnvSlice("ALLOWED_ORIGINS", []string{"*"}),
+		Env:                  getEnv("ENV", "development"),
+		Port:                 getEnv("PORT", "8080"),
+		PublicURL:            getEnv("PUBLIC_URL", ""),
+		DatabaseURL:          getEnv("DATABASE_URL", ""),
+		JWTAccessSecret:      getEnv("JWT_ACCESS_SECRET", ""),
+		JWTRefreshSecret:     getEnv("JWT_REFRESH_SECRET", ""),
+		AdminBootstrapToken:  getEnv("ADMIN_BOOTSTRAP_TOKEN", ""),
+		AdminEmail:           getEnv("ADMIN_EMAIL", "admin@example.com"),
+		AdminPassword:        getEnv("ADMIN_PASSWORD", ""),
+		AllowedOrigins:       getEnvSlice("ALLOWED_ORIGINS", []string{"*"}),
+		TrustedProxies:       getEnvSlice("TRUSTED_PROXIES", nil),
+		PaymentProvider:      getEnv("PAYMENT_PROVIDER", "none"),
+		PaymentWebhookSecret: getEnv("PAYMENT_WEBHOOK_SECRET", ""),
+		CommentBlockedWords:  getEnvSlice("COMMENT_BLOCKED_WORDS", nil),
+		PushProvider:         getEnv("PUSH_PROVIDER", "fake"),
+		PushFCMEndpoint:      getEnv("PUSH_FCM_ENDPOINT", ""),
+		PushFCMProjectID:     getEnv("PUSH_FCM_PROJECT_ID", ""),
+		PushFCMAccessToken:   getEnv("PUSH_FCM_ACCESS_TOKEN", ""),
 	}
 
 	// Parse durations
@@ -51,6 +79,56 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid REFRESH_TTL: %w", err)
 	}
 
+	cfg.PaymentTimeout, err = time.ParseDuration(getEnv("PAYMENT_TIMEOUT", "15m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PAYMENT_TIMEOUT: %w", err)
+	}
+
+	cfg.PushMaxAttempts, err = strconv.Atoi(getEnv("PUSH_MAX_ATTEMPTS", "3"))
+	if err != nil || cfg.PushMaxAttempts < 1 {
+		return nil, fmt.Errorf("invalid PUSH_MAX_ATTEMPTS: must be a positive integer")
+	}
+
+	cfg.PushRetryDelay, err = time.ParseDuration(getEnv("PUSH_RETRY_DELAY", "1s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PUSH_RETRY_DELAY: %w", err)
+	}
+
+	cfg.JobsEnabled = getEnv("JOBS_ENABLED", "true") == "true"
+
+	cfg.JobPollInterval, err = time.ParseDuration(getEnv("JOB_POLL_INTERVAL", "5s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JOB_POLL_INTERVAL: %w", err)
+	}
+
+	cfg.JobLease, err = time.ParseDuration(getEnv("JOB_LEASE", "5m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JOB_LEASE: %w", err)
+	}
+
+	cfg.JobRetention, err = time.ParseDuration(getEnv("JOB_RETENTION", "168h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JOB_RETENTION: %w", err)
+	}
+	if cfg.JobRetention <= 0 {
+		return nil, fmt.Errorf("invalid JOB_RETENTION: must be positive")
+	}
+
+	cfg.EventLifecycleEvery, err = time.ParseDuration(getEnv("EVENT_LIFECYCLE_INTERVAL", "1m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid EVENT_LIFECYCLE_INTERVAL: %w", err)
+	}
+
+	cfg.CancellationWindow, err = time.ParseDuration(getEnv("CANCELLATION_WINDOW", "2h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CANCELLATION_WINDOW: %w", err)
+	}
+
+	// Links handed out to clients, such as calendar feeds, need an absolute URL
+	if cfg.PublicURL == "" {
+		cfg.PublicURL = "http://localhost:" + cfg.Port
+	}
+
 	// Validate required fields
 	if cfg.DatabaseURL == "" {
 		return nil, fmt.Errorf("DATABASE_URL is required")
@@ -61,6 +139,13 @@ func Load() (*Config, error) {
 	if cfg.JWTRefreshSecret == "" {
 		return nil, fmt.Errorf("JWT_REFRESH_SECRET is required")
 	}
+	if cfg.PaymentProvider != "none" && cfg.PaymentWebhookSecret == "" {
+		return nil, fmt.Errorf("PAYMENT_WEBHOOK_SECRET is required when PAYMENT_PROVIDER is set")
+	}
+	// The fake provider confirms payments on request, so it must never face real users
+	if cfg.Env == "production" && cfg.PaymentProvider == "fake" {
+		return nil, fmt.Errorf("PAYMENT_PROVIDER=fake is not allowed when ENV=production")
+	}
 
 	log.Printf("Config loaded: ENV=%s, PORT=%s", cfg.Env, cfg.Port)
 	return cfg, nil
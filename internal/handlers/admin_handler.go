@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"playspotter/internal/middlewares"
 	"playspotter/internal/services"
 	"playspotter/internal/utils"
 
@@ -26,7 +28,8 @@ type UpdateRoleRequest struct {
 }
 
 type UpdateStatusRequest struct {
-	Status string `json:"status" binding:"required,oneof=open full cancelled"`
+	Status string `json:"status" binding:"required,oneof=open full ongoing completed cancelled"`
+	Reason string `json:"reason" binding:"required_if=Status cancelled,max=500"`
 }
 
 // ListUsers godoc
@@ -95,7 +98,7 @@ func (h *AdminHandler) UpdateUserRole(c *gin.Context) {
 		return
 	}
 
-	if err := h.userService.UpdateUserRole(id, req.Role); err != nil {
+	if err := h.userService.UpdateUserRole(id, req.Role, auditMeta(c)); err != nil {
 		utils.RespondError(c, http.StatusInternalServerError, "update_failed", err.Error())
 		return
 	}
@@ -106,11 +109,12 @@ func (h *AdminHandler) UpdateUserRole(c *gin.Context) {
 
 // ListAllEvents godoc
 // @Summary List all events (admin only)
-// @Description Get paginated list of all events regardless of status
+// @Description Get paginated list of all events regardless of status, or only those in one status
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param status query string false "Event status (draft, open, full, ongoing, completed, cancelled)"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
 // @Success 200 {object} utils.SuccessResponse
@@ -118,21 +122,19 @@ func (h *AdminHandler) UpdateUserRole(c *gin.Context) {
 // @Failure 403 {object} utils.ErrorResponse
 // @Router /admin/events [get]
 func (h *AdminHandler) ListAllEvents(c *gin.Context) {
-	pagination := utils.NewPaginationParams(
-		c.GetInt("page"),
-		c.GetInt("limit"),
-	)
-
 	// Bind query params
 	var query struct {
-		Page  int `form:"page"`
-		Limit int `form:"limit"`
+		Status string `form:"status" binding:"omitempty,oneof=draft open full ongoing completed cancelled"`
+		Page   int    `form:"page"`
+		Limit  int    `form:"limit"`
 	}
-	if err := c.ShouldBindQuery(&query); err == nil {
-		pagination = utils.NewPaginationParams(query.Page, query.Limit)
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
 	}
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
 
-	events, total, err := h.eventService.ListAllEvents(pagination.GetOffset(), pagination.Limit)
+	events, total, err := h.eventService.ListAllEvents(query.Status, pagination.GetOffset(), pagination.Limit)
 	if err != nil {
 		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch events")
 		return
@@ -144,7 +146,7 @@ func (h *AdminHandler) ListAllEvents(c *gin.Context) {
 
 // UpdateEventStatus godoc
 // @Summary Update event status (admin only)
-// @Description Change an event's status
+// @Description Change an event's status. Cancelling needs a reason and refunds paid participants; cancelled events are reinstated with uncancel instead.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -158,6 +160,12 @@ func (h *AdminHandler) ListAllEvents(c *gin.Context) {
 // @Failure 404 {object} utils.ErrorResponse
 // @Router /admin/events/{id}/status [put]
 func (h *AdminHandler) UpdateEventStatus(c *gin.Context) {
+	adminID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
@@ -170,11 +178,51 @@ func (h *AdminHandler) UpdateEventStatus(c *gin.Context) {
 		return
 	}
 
-	if err := h.eventService.UpdateStatus(id, req.Status); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "update_failed", err.Error())
+	if err := h.eventService.UpdateStatus(id, req.Status, req.Reason, adminID, auditMeta(c)); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "update_failed", err.Error())
 		return
 	}
 
 	event, _ := h.eventService.GetEvent(id)
 	utils.RespondSuccess(c, event)
 }
+
+// UncancelEvent godoc
+// @Summary Reinstate a cancelled event (admin only)
+// @Description Reopen a cancelled event that has not started yet. Participants whose payments were refunded lose their spot and are asked to join again; the rest must fit the capacity.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/events/{id}/uncancel [post]
+func (h *AdminHandler) UncancelEvent(c *gin.Context) {
+	adminID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	event, err := h.eventService.UncancelEvent(id, adminID)
+	if err != nil {
+		if errors.Is(err, services.ErrEventNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "uncancel_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, event)
+}
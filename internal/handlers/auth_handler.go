@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"playspotter/internal/services"
 	"playspotter/internal/utils"
@@ -83,7 +84,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 // Login godoc
 // @Summary Login user
-// @Description Authenticate user and return tokens
+// @Description Authenticate user and return tokens. Suspended and banned accounts get 403 account_restricted.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -91,6 +92,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 // @Success 200 {object} utils.SuccessResponse
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -100,12 +102,16 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	accessToken, refreshToken, user, err := h.authService.Login(req.Email, req.Password)
+	accessToken, refreshToken, user, err := h.authService.Login(req.Email, req.Password, auditMeta(c))
 	if err != nil {
 		if err.Error() == "invalid credentials" {
 			utils.RespondError(c, http.StatusUnauthorized, "invalid_credentials", err.Error())
 			return
 		}
+		if errors.Is(err, services.ErrAccountSuspended) || errors.Is(err, services.ErrAccountBanned) {
+			utils.RespondError(c, http.StatusForbidden, "account_restricted", err.Error())
+			return
+		}
 		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to login")
 		return
 	}
@@ -132,6 +138,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 // @Success 200 {object} utils.SuccessResponse
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
 // @Failure 500 {object} utils.ErrorResponse
 // @Router /auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
@@ -143,6 +150,10 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 
 	accessToken, refreshToken, err := h.authService.RefreshToken(req.RefreshToken)
 	if err != nil {
+		if errors.Is(err, services.ErrAccountSuspended) || errors.Is(err, services.ErrAccountBanned) {
+			utils.RespondError(c, http.StatusForbidden, "account_restricted", err.Error())
+			return
+		}
 		utils.RespondError(c, http.StatusUnauthorized, "invalid_refresh_token", err.Error())
 		return
 	}
@@ -166,7 +177,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 func (h *AuthHandler) Logout(c *gin.Context) {
 	var req LogoutRequest
 	if err := c.ShouldBindJSON(&req); err == nil && req.RefreshToken != "" {
-		_ = h.authService.Logout(req.RefreshToken)
+		_ = h.authService.Logout(req.RefreshToken, auditMeta(c))
 	}
 
 	utils.RespondSuccess(c, gin.H{"message": "Logged out successfully"})
@@ -192,7 +203,7 @@ func (h *AuthHandler) BootstrapAdmin(c *gin.Context, adminEmail, adminPassword,
 		return
 	}
 
-	err := h.authService.BootstrapAdmin(adminEmail, adminPassword)
+	err := h.authService.BootstrapAdmin(adminEmail, adminPassword, auditMeta(c))
 	if err != nil {
 		if err.Error() == "admin already exists" {
 			utils.RespondError(c, http.StatusConflict, "admin_exists", err.Error())
@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"playspotter/internal/ical"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CalendarHandler struct {
+	calendarService *services.CalendarService
+	publicURL       string
+}
+
+func NewCalendarHandler(calendarService *services.CalendarService, publicURL string) *CalendarHandler {
+	return &CalendarHandler{
+		calendarService: calendarService,
+		publicURL:       strings.TrimRight(publicURL, "/"),
+	}
+}
+
+type CreateCalendarFeedRequest struct {
+	IncludeLiked bool `json:"include_liked"`
+}
+
+// GetEventICS godoc
+// @Summary Download an event as iCalendar
+// @Description Get the event as an RFC 5545 .ics file with a single VEVENT
+// @Tags calendar
+// @Produce text/calendar
+// @Param id path string true "Event ID"
+// @Success 200 {string} string "iCalendar document"
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/ics [get]
+func (h *CalendarHandler) GetEventICS(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	cal, err := h.calendarService.EventCalendar(id, viewer, isAdmin)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="event-%s.ics"`, id))
+	h.writeCalendar(c, cal)
+}
+
+// GetFeedICS godoc
+// @Summary Calendar subscription feed
+// @Description iCalendar feed of the events the feed's owner joined (and liked, if enabled). The secret token in the URL is the only authentication.
+// @Tags calendar
+// @Produce text/calendar
+// @Param token path string true "Feed token, optionally followed by .ics"
+// @Success 200 {string} string "iCalendar document"
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /calendar/{token} [get]
+func (h *CalendarHandler) GetFeedICS(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	cal, err := h.calendarService.FeedCalendar(token)
+	if err != nil {
+		if errors.Is(err, services.ErrCalendarFeedNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "feed_not_found", "Calendar feed not found")
+			return
+		}
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to build calendar")
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=300")
+	h.writeCalendar(c, cal)
+}
+
+// GetCalendarFeed godoc
+// @Summary Get my calendar feed settings
+// @Description Get whether you have a calendar subscription and its settings. The URL is only shown when the feed is created.
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /me/calendar-feed [get]
+func (h *CalendarHandler) GetCalendarFeed(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	feed, err := h.calendarService.GetFeed(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "feed_not_found", "Calendar feed not found")
+		return
+	}
+
+	utils.RespondSuccess(c, feed)
+}
+
+// CreateCalendarFeed godoc
+// @Summary Create my calendar feed
+// @Description Create a secret subscription URL for your calendar app. Calling it again replaces the URL and the old one stops working.
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateCalendarFeedRequest false "Feed settings"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/calendar-feed [post]
+func (h *CalendarHandler) CreateCalendarFeed(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req CreateCalendarFeedRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+	}
+
+	token, feed, err := h.calendarService.CreateFeed(userID, req.IncludeLiked)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to create calendar feed")
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{
+		Data: gin.H{
+			"url":           h.publicURL + "/calendar/" + token + ".ics",
+			"include_liked": feed.IncludeLiked,
+			"created_at":    feed.CreatedAt,
+		},
+	})
+}
+
+// DeleteCalendarFeed godoc
+// @Summary Revoke my calendar feed
+// @Description Stop serving your calendar subscription URL
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /me/calendar-feed [delete]
+func (h *CalendarHandler) DeleteCalendarFeed(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	if err := h.calendarService.DeleteFeed(userID); err != nil {
+		if errors.Is(err, services.ErrCalendarFeedNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "feed_not_found", "Calendar feed not found")
+			return
+		}
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to revoke calendar feed")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Calendar feed revoked"})
+}
+
+func (h *CalendarHandler) writeCalendar(c *gin.Context, cal ical.Calendar) {
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, cal); err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to build calendar")
+		return
+	}
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", buf.Bytes())
+}
@@ -0,0 +1,474 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CommentHandler struct {
+	commentService *services.CommentService
+}
+
+func NewCommentHandler(commentService *services.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+type CreateCommentRequest struct {
+	Body     string     `json:"body" binding:"required,max=2000"`
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,max=2000"`
+}
+
+type HideCommentRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// ListComments godoc
+// @Summary List event comments
+// @Description Get the event discussion: top-level comments (pinned first, then newest) with their replies. Discussions of participants-only events require being a participant or organizer.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/comments [get]
+func (h *CommentHandler) ListComments(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	var query struct {
+		Page  int `form:"page"`
+		Limit int `form:"limit"`
+	}
+	_ = c.ShouldBindQuery(&query)
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	comments, total, err := h.commentService.ListComments(id, viewer, isAdmin, pagination.GetOffset(), pagination.Limit)
+	if err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", "Only participants can see this discussion")
+			return
+		}
+		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+		return
+	}
+
+	result := make([]gin.H, 0, len(comments))
+	for _, comment := range comments {
+		entry := commentResponse(comment, isAdmin)
+		replies := make([]gin.H, 0, len(comment.Replies))
+		for _, reply := range comment.Replies {
+			replies = append(replies, commentResponse(reply, isAdmin))
+		}
+		entry["replies"] = replies
+		result = append(result, entry)
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, result, &meta)
+}
+
+// CreateComment godoc
+// @Summary Post a comment
+// @Description Post a comment on an event, or reply to one with parent_id
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body CreateCommentRequest true "Comment"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/comments [post]
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	comment, err := h.commentService.CreateComment(id, userID, isAdmin, req.Body, req.ParentID)
+	if err != nil {
+		respondCommentError(c, err, "comment_failed")
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{Data: commentResponse(*comment, isAdmin)})
+}
+
+// UpdateComment godoc
+// @Summary Edit a comment
+// @Description Edit your own comment
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param commentId path string true "Comment ID"
+// @Param request body UpdateCommentRequest true "Comment"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/comments/{commentId} [put]
+func (h *CommentHandler) UpdateComment(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, commentID, ok := parseCommentParams(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	comment, err := h.commentService.UpdateComment(id, commentID, userID, req.Body)
+	if err != nil {
+		respondCommentError(c, err, "update_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, commentResponse(*comment, isAdmin))
+}
+
+// DeleteComment godoc
+// @Summary Delete a comment
+// @Description Delete a comment (author, owner, co-host or admin); replies stay in the thread
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param commentId path string true "Comment ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/comments/{commentId} [delete]
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, commentID, ok := parseCommentParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.commentService.DeleteComment(id, commentID, userID, isAdmin); err != nil {
+		respondCommentError(c, err, "delete_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Comment deleted successfully"})
+}
+
+// PinComment godoc
+// @Summary Pin a comment
+// @Description Pin a top-level comment to the top of the discussion (owner, co-host or admin only)
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param commentId path string true "Comment ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/comments/{commentId}/pin [post]
+func (h *CommentHandler) PinComment(c *gin.Context) {
+	h.setPinned(c, true, "Comment pinned")
+}
+
+// UnpinComment godoc
+// @Summary Unpin a comment
+// @Description Unpin a comment (owner, co-host or admin only)
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param commentId path string true "Comment ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/comments/{commentId}/pin [delete]
+func (h *CommentHandler) UnpinComment(c *gin.Context) {
+	h.setPinned(c, false, "Comment unpinned")
+}
+
+// AdminListComments godoc
+// @Summary List comments for moderation (admin only)
+// @Description List comments across events, newest first, optionally only hidden ones or one event's
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param event_id query string false "Event ID"
+// @Param hidden query bool false "Only hidden comments"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /admin/comments [get]
+func (h *CommentHandler) AdminListComments(c *gin.Context) {
+	var query struct {
+		EventID string `form:"event_id"`
+		Hidden  bool   `form:"hidden"`
+		Page    int    `form:"page"`
+		Limit   int    `form:"limit"`
+	}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	filter := repositories.CommentFilter{
+		HiddenOnly: query.Hidden,
+		Offset:     pagination.GetOffset(),
+		Limit:      pagination.Limit,
+	}
+	if query.EventID != "" {
+		eventID, err := uuid.Parse(query.EventID)
+		if err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+			return
+		}
+		filter.EventID = &eventID
+	}
+
+	comments, total, err := h.commentService.ListForModeration(filter)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch comments")
+		return
+	}
+
+	result := make([]gin.H, 0, len(comments))
+	for _, comment := range comments {
+		entry := commentResponse(comment, true)
+		entry["event_id"] = comment.EventID
+		entry["parent_id"] = comment.ParentID
+		result = append(result, entry)
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, result, &meta)
+}
+
+// HideComment godoc
+// @Summary Hide a comment (admin only)
+// @Description Hide a comment from everyone but admins, with a reason
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Param request body HideCommentRequest true "Reason"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/comments/{id}/hide [post]
+func (h *CommentHandler) HideComment(c *gin.Context) {
+	adminID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid comment ID")
+		return
+	}
+
+	var req HideCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	if err := h.commentService.HideComment(id, adminID, req.Reason); err != nil {
+		respondCommentError(c, err, "hide_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Comment hidden"})
+}
+
+// UnhideComment godoc
+// @Summary Restore a hidden comment (admin only)
+// @Description Make a hidden comment visible again
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/comments/{id}/hide [delete]
+func (h *CommentHandler) UnhideComment(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid comment ID")
+		return
+	}
+
+	if err := h.commentService.UnhideComment(id); err != nil {
+		respondCommentError(c, err, "unhide_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Comment restored"})
+}
+
+func (h *CommentHandler) setPinned(c *gin.Context, pinned bool, message string) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, commentID, ok := parseCommentParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.commentService.SetPinned(id, commentID, userID, isAdmin, pinned); err != nil {
+		respondCommentError(c, err, "pin_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": message})
+}
+
+func parseCommentParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid comment ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return id, commentID, true
+}
+
+// commentResponse shows the author's name only; the body of deleted comments is
+// withheld, and moderation details are only shown to admins
+func commentResponse(comment models.EventComment, isAdmin bool) gin.H {
+	entry := gin.H{
+		"id":         comment.ID,
+		"user_id":    comment.UserID,
+		"body":       comment.Body,
+		"pinned":     comment.Pinned,
+		"edited_at":  comment.EditedAt,
+		"deleted":    comment.DeletedAt != nil,
+		"created_at": comment.CreatedAt,
+	}
+	if comment.User != nil {
+		entry["author_name"] = comment.User.Name
+	}
+	if comment.DeletedAt != nil && !isAdmin {
+		entry["body"] = nil
+	}
+	if isAdmin {
+		entry["hidden_at"] = comment.HiddenAt
+		entry["hidden_reason"] = comment.HiddenReason
+		entry["deleted_by"] = comment.DeletedBy
+	}
+	return entry
+}
+
+func respondCommentError(c *gin.Context, err error, code string) {
+	switch {
+	case errors.Is(err, services.ErrEventForbidden):
+		utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+	case errors.Is(err, services.ErrEventNotFound):
+		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+	case errors.Is(err, services.ErrCommentNotFound):
+		utils.RespondError(c, http.StatusNotFound, "comment_not_found", "Comment not found")
+	default:
+		utils.RespondError(c, http.StatusBadRequest, code, err.Error())
+	}
+}
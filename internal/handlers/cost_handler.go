@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CostHandler struct {
+	costService *services.CostService
+}
+
+func NewCostHandler(costService *services.CostService) *CostHandler {
+	return &CostHandler{
+		costService: costService,
+	}
+}
+
+type SplitCostsRequest struct {
+	TotalCents int                   `json:"total_cents" binding:"required,min=1"`
+	Currency   string                `json:"currency" binding:"omitempty,len=3"`
+	Note       *string               `json:"note" binding:"omitempty,max=500"`
+	Exempt     []uuid.UUID           `json:"exempt"`
+	FixedCents map[uuid.UUID]int     `json:"fixed_cents"`
+	Weights    map[uuid.UUID]float64 `json:"weights"`
+}
+
+// GetCostSplit godoc
+// @Summary Get event cost split
+// @Description Get the cost split of an event with each participant's share and settlement status (organizers and participants)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/costs [get]
+func (h *CostHandler) GetCostSplit(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	split, err := h.costService.GetSplit(id, userID, isAdmin)
+	if err != nil {
+		respondCostError(c, err, "cost_split_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, costSplitResponse(split))
+}
+
+// SplitCosts godoc
+// @Summary Split event costs
+// @Description Enter the total cost after the game and split it among checked-in participants, with optional exemptions, fixed amounts and weights (owner, co-host or admin only)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body SplitCostsRequest true "Cost details"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/costs [post]
+func (h *CostHandler) SplitCosts(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var req SplitCostsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	split, err := h.costService.SplitCosts(id, userID, isAdmin, services.SplitCostsInput{
+		TotalCents: req.TotalCents,
+		Currency:   req.Currency,
+		Note:       req.Note,
+		Exempt:     req.Exempt,
+		FixedCents: req.FixedCents,
+		Weights:    req.Weights,
+	})
+	if err != nil {
+		respondCostError(c, err, "cost_split_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, costSplitResponse(split))
+}
+
+// DeleteCostSplit godoc
+// @Summary Delete event cost split
+// @Description Remove the cost split while nobody has settled (owner, co-host or admin only)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/costs [delete]
+func (h *CostHandler) DeleteCostSplit(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	if err := h.costService.DeleteSplit(id, userID, isAdmin); err != nil {
+		respondCostError(c, err, "delete_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Cost split deleted successfully"})
+}
+
+// SettleShare godoc
+// @Summary Mark a share as settled
+// @Description Record that a participant has paid their share (owner, co-host or admin only)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param userId path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/costs/shares/{userId}/settle [post]
+func (h *CostHandler) SettleShare(c *gin.Context) {
+	h.setSettled(c, true, "Share marked as settled")
+}
+
+// UnsettleShare godoc
+// @Summary Revert a settled share
+// @Description Mark a participant's share as unpaid again (owner, co-host or admin only)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param userId path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/costs/shares/{userId}/settle [delete]
+func (h *CostHandler) UnsettleShare(c *gin.Context) {
+	h.setSettled(c, false, "Share marked as unsettled")
+}
+
+// SendCostReminders godoc
+// @Summary Remind unsettled participants
+// @Description Remind participants who have not paid their share; each participant is reminded at most once a day (owner, co-host or admin only)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/costs/remind [post]
+func (h *CostHandler) SendCostReminders(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	reminded, err := h.costService.SendReminders(id, userID, isAdmin)
+	if err != nil {
+		respondCostError(c, err, "reminder_failed")
+		return
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(reminded))
+	for _, share := range reminded {
+		userIDs = append(userIDs, share.UserID)
+	}
+
+	utils.RespondSuccess(c, gin.H{"reminded": userIDs})
+}
+
+// ListMyCosts godoc
+// @Summary List my outstanding costs
+// @Description Get the cost shares the current user still has to pay
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/costs [get]
+func (h *CostHandler) ListMyCosts(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	shares, err := h.costService.ListOutstanding(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to list costs")
+		return
+	}
+
+	result := make([]gin.H, 0, len(shares))
+	for _, share := range shares {
+		entry := gin.H{
+			"event_id":         share.EventID,
+			"amount_cents":     share.AmountCents,
+			"last_reminded_at": share.LastRemindedAt,
+		}
+		if share.Event != nil {
+			entry["event_title"] = share.Event.Title
+			entry["event_time"] = share.Event.EventTime
+		}
+		result = append(result, entry)
+	}
+
+	utils.RespondSuccess(c, result)
+}
+
+func (h *CostHandler) setSettled(c *gin.Context, settled bool, message string) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	targetID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := h.costService.SetSettled(id, targetID, userID, isAdmin, settled); err != nil {
+		respondCostError(c, err, "settle_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": message})
+}
+
+// costSplitResponse lists shares with participant names but without the rest of the user record
+func costSplitResponse(split *models.EventCostSplit) gin.H {
+	shares := make([]gin.H, 0, len(split.Shares))
+	settledCents := 0
+	for _, share := range split.Shares {
+		entry := gin.H{
+			"user_id":          share.UserID,
+			"amount_cents":     share.AmountCents,
+			"exempt":           share.Exempt,
+			"settled_at":       share.SettledAt,
+			"last_reminded_at": share.LastRemindedAt,
+			"reminder_count":   share.ReminderCount,
+		}
+		if share.User != nil {
+			entry["name"] = share.User.Name
+		}
+		if share.SettledAt != nil {
+			settledCents += share.AmountCents
+		}
+		shares = append(shares, entry)
+	}
+
+	return gin.H{
+		"id":                split.ID,
+		"event_id":          split.EventID,
+		"total_cents":       split.TotalCents,
+		"currency":          split.Currency,
+		"note":              split.Note,
+		"settled_cents":     settledCents,
+		"outstanding_cents": split.TotalCents - settledCents,
+		"created_by":        split.CreatedBy,
+		"created_at":        split.CreatedAt,
+		"shares":            shares,
+	}
+}
+
+func respondCostError(c *gin.Context, err error, code string) {
+	switch {
+	case errors.Is(err, services.ErrEventForbidden):
+		utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+	case errors.Is(err, services.ErrCostSplitNotFound):
+		utils.RespondError(c, http.StatusNotFound, "cost_split_not_found", "Cost split not found")
+	default:
+		utils.RespondError(c, http.StatusBadRequest, code, err.Error())
+	}
+}
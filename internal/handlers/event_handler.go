@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"playspotter/internal/middlewares"
 	"playspotter/internal/models"
@@ -26,27 +27,70 @@ func NewEventHandler(eventService *services.EventService, swipeService *services
 }
 
 type CreateEventRequest struct {
-	Title        string  `json:"title" binding:"required,max=120"`
-	SportType    string  `json:"sport_type" binding:"required,max=50"`
-	EventTime    string  `json:"event_time" binding:"required"`
-	LocationName *string `json:"location_name" binding:"omitempty,max=160"`
-	Address      *string `json:"address"`
-	Latitude     float64 `json:"latitude" binding:"required,min=-90,max=90"`
-	Longitude    float64 `json:"longitude" binding:"required,min=-180,max=180"`
-	Capacity     int     `json:"capacity" binding:"required,min=1"`
-	Description  *string `json:"description"`
+	Title        string     `json:"title" binding:"required,max=120"`
+	SportType    string     `json:"sport_type" binding:"required,max=50"`
+	EventTime    string     `json:"event_time" binding:"required"`
+	VenueID      *uuid.UUID `json:"venue_id"`
+	LocationName *string    `json:"location_name" binding:"omitempty,max=160"`
+	Address      *string    `json:"address"`
+	Latitude     *float64   `json:"latitude" binding:"required_without=VenueID,omitempty,min=-90,max=90"`
+	Longitude    *float64   `json:"longitude" binding:"required_without=VenueID,omitempty,min=-180,max=180"`
+	Capacity     int        `json:"capacity" binding:"required,min=1"`
+	Description  *string    `json:"description"`
+	PriceCents   int        `json:"price_cents" binding:"omitempty,min=0"`
+	Currency     string     `json:"currency" binding:"omitempty,len=3"`
+	Visibility   string     `json:"visibility" binding:"omitempty,oneof=public participants"`
+	DurationMin  int        `json:"duration_minutes" binding:"omitempty,min=15,max=1440"`
+	TimeZone     string     `json:"time_zone" binding:"omitempty,max=64"`
+	Draft        bool       `json:"draft"`
+	PublishAt    string     `json:"publish_at"`
 }
 
 type UpdateEventRequest struct {
-	Title        string   `json:"title" binding:"omitempty,max=120"`
-	SportType    string   `json:"sport_type" binding:"omitempty,max=50"`
-	EventTime    string   `json:"event_time"`
-	LocationName *string  `json:"location_name" binding:"omitempty,max=160"`
-	Address      *string  `json:"address"`
-	Latitude     *float64 `json:"latitude" binding:"omitempty,min=-90,max=90"`
-	Longitude    *float64 `json:"longitude" binding:"omitempty,min=-180,max=180"`
-	Capacity     *int     `json:"capacity" binding:"omitempty,min=1"`
-	Description  *string  `json:"description"`
+	Title        string     `json:"title" binding:"omitempty,max=120"`
+	SportType    string     `json:"sport_type" binding:"omitempty,max=50"`
+	EventTime    string     `json:"event_time"`
+	VenueID      *uuid.UUID `json:"venue_id"`
+	LocationName *string    `json:"location_name" binding:"omitempty,max=160"`
+	Address      *string    `json:"address"`
+	Latitude     *float64   `json:"latitude" binding:"omitempty,min=-90,max=90"`
+	Longitude    *float64   `json:"longitude" binding:"omitempty,min=-180,max=180"`
+	Capacity     *int       `json:"capacity" binding:"omitempty,min=1"`
+	Description  *string    `json:"description"`
+	PriceCents   *int       `json:"price_cents" binding:"omitempty,min=0"`
+	Currency     string     `json:"currency" binding:"omitempty,len=3"`
+	Visibility   string     `json:"visibility" binding:"omitempty,oneof=public participants"`
+	DurationMin  int        `json:"duration_minutes" binding:"omitempty,min=15,max=1440"`
+	TimeZone     string     `json:"time_zone" binding:"omitempty,max=64"`
+}
+
+type PublishEventRequest struct {
+	PublishAt string `json:"publish_at"`
+}
+
+// CopyEventRequest sets the new occurrence when an event is cloned or created from a template
+type CopyEventRequest struct {
+	EventTime string `json:"event_time" binding:"required"`
+	Title     string `json:"title" binding:"omitempty,max=120"`
+	Draft     bool   `json:"draft"`
+	PublishAt string `json:"publish_at"`
+}
+
+type CoHostRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+}
+
+type TransferOwnershipRequest struct {
+	UserID       uuid.UUID `json:"user_id" binding:"required"`
+	KeepAsCoHost *bool     `json:"keep_as_cohost"`
+}
+
+type CancelEventRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type ParticipantActionRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
 }
 
 type SwipeRequest struct {
@@ -58,15 +102,52 @@ type EventFeedQuery struct {
 	Lng         *float64 `form:"lng" binding:"omitempty,min=-180,max=180"`
 	MaxDistance *float64 `form:"max_distance_km" binding:"omitempty,min=0"`
 	SportType   string   `form:"sport_type"`
+	VenueID     string   `form:"venue_id"`
 	DateFrom    string   `form:"date_from"`
 	DateTo      string   `form:"date_to"`
+	Following   bool     `form:"following"`
 	Page        int      `form:"page" binding:"omitempty,min=1"`
 	Limit       int      `form:"limit" binding:"omitempty,min=1,max=100"`
 }
 
+// eventTimeFormatMessage explains the start times parseEventTime accepts
+const eventTimeFormatMessage = "Event time must be RFC3339 or a local time without offset (2006-01-02T15:04)"
+
+// localTimeLayouts are start times without an offset, read in the event's time zone
+var localTimeLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+// parseEventTime accepts RFC3339 or a wall-clock time; local reports the latter
+func parseEventTime(value string) (t time.Time, local bool, err error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), false, nil
+	}
+	for _, layout := range localTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true, nil
+		}
+	}
+	return time.Time{}, false, errors.New("invalid time")
+}
+
+// parseDateBound parses a date filter. A bare date as the upper bound covers the whole day.
+func parseDateBound(value string, upper bool) (*repositories.DateBound, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		if upper {
+			t = t.Add(24*time.Hour - time.Microsecond)
+		}
+		return &repositories.DateBound{Time: t, Local: true}, nil
+	}
+
+	t, local, err := parseEventTime(value)
+	if err != nil {
+		return nil, err
+	}
+	return &repositories.DateBound{Time: t, Local: local}, nil
+}
+
 // CreateEvent godoc
 // @Summary Create a new event
-// @Description Create a new sports event
+// @Description Create a new sports event. Drafts (draft=true, or a publish_at time) stay hidden until published.
 // @Tags events
 // @Accept json
 // @Produce json
@@ -91,9 +172,9 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 	}
 
 	// Parse event time
-	eventTime, err := time.Parse(time.RFC3339, req.EventTime)
+	eventTime, local, err := parseEventTime(req.EventTime)
 	if err != nil {
-		utils.RespondError(c, http.StatusBadRequest, "invalid_time_format", "Event time must be in RFC3339 format")
+		utils.RespondError(c, http.StatusBadRequest, "invalid_time_format", eventTimeFormatMessage)
 		return
 	}
 
@@ -101,13 +182,38 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 		CreatorID:    userID,
 		Title:        req.Title,
 		SportType:    req.SportType,
-		EventTime:    eventTime.UTC(),
+		TimeZone:     req.TimeZone,
+		VenueID:      req.VenueID,
 		LocationName: req.LocationName,
 		Address:      req.Address,
-		Latitude:     req.Latitude,
-		Longitude:    req.Longitude,
 		Capacity:     req.Capacity,
 		Description:  req.Description,
+		PriceCents:   req.PriceCents,
+		Currency:     req.Currency,
+		Visibility:   req.Visibility,
+		DurationMin:  req.DurationMin,
+	}
+	if req.Latitude != nil {
+		event.Latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		event.Longitude = *req.Longitude
+	}
+	if local {
+		event.LocalEventTime = eventTime
+	} else {
+		event.EventTime = eventTime
+	}
+	if req.Draft {
+		event.Status = models.EventStatusDraft
+	}
+	if req.PublishAt != "" {
+		publishAt, err := time.Parse(time.RFC3339, req.PublishAt)
+		if err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "invalid_time_format", "Publish time must be in RFC3339 format")
+			return
+		}
+		event.PublishAt = &publishAt
 	}
 
 	if err := h.eventService.CreateEvent(event); err != nil {
@@ -122,7 +228,7 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 
 // GetEvent godoc
 // @Summary Get event by ID
-// @Description Get detailed information about a specific event
+// @Description Get detailed information about a specific event. Drafts are only visible to their organizers. The organizer and co-hosts are shown with their public name and avatar only.
 // @Tags events
 // @Accept json
 // @Produce json
@@ -137,13 +243,20 @@ func (h *EventHandler) GetEvent(c *gin.Context) {
 		return
 	}
 
-	event, err := h.eventService.GetEvent(id)
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	event, err := h.eventService.ViewEvent(id, viewer, isAdmin)
 	if err != nil {
 		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
 		return
 	}
 
-	utils.RespondSuccess(c, event)
+	utils.RespondSuccess(c, newEventResponse(event))
 }
 
 // ListEvents godoc
@@ -156,12 +269,15 @@ func (h *EventHandler) GetEvent(c *gin.Context) {
 // @Param lng query number false "Longitude"
 // @Param max_distance_km query number false "Maximum distance in km"
 // @Param sport_type query string false "Sport type"
-// @Param date_from query string false "Date from (RFC3339)"
-// @Param date_to query string false "Date to (RFC3339)"
+// @Param venue_id query string false "Venue ID"
+// @Param date_from query string false "Date from (RFC3339, or a date or time without offset read in each event's zone)"
+// @Param date_to query string false "Date to (RFC3339, or a date or time without offset read in each event's zone; a date includes the whole day)"
+// @Param following query bool false "Only events created or joined by people you follow (requires authentication)"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
 // @Success 200 {object} utils.SuccessResponse
 // @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
 // @Router /events [get]
 func (h *EventHandler) ListEvents(c *gin.Context) {
 	var query EventFeedQuery
@@ -174,22 +290,45 @@ func (h *EventHandler) ListEvents(c *gin.Context) {
 	pagination := utils.NewPaginationParams(query.Page, query.Limit)
 
 	// Parse dates if provided
-	var dateFrom, dateTo *time.Time
+	var dateFrom, dateTo *repositories.DateBound
 	if query.DateFrom != "" {
-		t, err := time.Parse(time.RFC3339, query.DateFrom)
+		bound, err := parseDateBound(query.DateFrom, false)
 		if err != nil {
-			utils.RespondError(c, http.StatusBadRequest, "invalid_date_format", "date_from must be in RFC3339 format")
+			utils.RespondError(c, http.StatusBadRequest, "invalid_date_format", "date_from must be RFC3339, a local date (2006-01-02) or a local time (2006-01-02T15:04)")
 			return
 		}
-		dateFrom = &t
+		dateFrom = bound
 	}
 	if query.DateTo != "" {
-		t, err := time.Parse(time.RFC3339, query.DateTo)
+		bound, err := parseDateBound(query.DateTo, true)
+		if err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "invalid_date_format", "date_to must be RFC3339, a local date (2006-01-02) or a local time (2006-01-02T15:04)")
+			return
+		}
+		dateTo = bound
+	}
+
+	var venueID *uuid.UUID
+	if query.VenueID != "" {
+		id, err := uuid.Parse(query.VenueID)
 		if err != nil {
-			utils.RespondError(c, http.StatusBadRequest, "invalid_date_format", "date_to must be in RFC3339 format")
+			utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid venue ID")
+			return
+		}
+		venueID = &id
+	}
+
+	// Signed-in viewers do not see events of users they blocked or were blocked by
+	var viewer, followedBy *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	if query.Following {
+		if viewer == nil {
+			utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "Sign in to see events from people you follow")
 			return
 		}
-		dateTo = &t
+		followedBy = viewer
 	}
 
 	filter := repositories.EventFilter{
@@ -197,9 +336,12 @@ func (h *EventHandler) ListEvents(c *gin.Context) {
 		Lng:         query.Lng,
 		MaxDistance: query.MaxDistance,
 		SportType:   query.SportType,
+		VenueID:     venueID,
 		DateFrom:    dateFrom,
 		DateTo:      dateTo,
-		Status:      "open",
+		Status:      models.EventStatusOpen,
+		FollowedBy:  followedBy,
+		Viewer:      viewer,
 		Offset:      pagination.GetOffset(),
 		Limit:       pagination.Limit,
 	}
@@ -216,7 +358,7 @@ func (h *EventHandler) ListEvents(c *gin.Context) {
 
 // UpdateEvent godoc
 // @Summary Update event
-// @Description Update event details (creator or admin only)
+// @Description Update event details (owner, co-host or admin only)
 // @Tags events
 // @Accept json
 // @Produce json
@@ -254,18 +396,26 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 	updates := &models.Event{
 		Title:        req.Title,
 		SportType:    req.SportType,
+		VenueID:      req.VenueID,
 		LocationName: req.LocationName,
 		Address:      req.Address,
 		Description:  req.Description,
+		Visibility:   req.Visibility,
+		DurationMin:  req.DurationMin,
+		TimeZone:     req.TimeZone,
 	}
 
 	if req.EventTime != "" {
-		eventTime, err := time.Parse(time.RFC3339, req.EventTime)
+		eventTime, local, err := parseEventTime(req.EventTime)
 		if err != nil {
-			utils.RespondError(c, http.StatusBadRequest, "invalid_time_format", "Event time must be in RFC3339 format")
+			utils.RespondError(c, http.StatusBadRequest, "invalid_time_format", eventTimeFormatMessage)
 			return
 		}
-		updates.EventTime = eventTime.UTC()
+		if local {
+			updates.LocalEventTime = eventTime
+		} else {
+			updates.EventTime = eventTime
+		}
 	}
 
 	if req.Latitude != nil {
@@ -279,7 +429,7 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 	}
 
 	if err := h.eventService.UpdateEvent(id, updates, userID, isAdmin); err != nil {
-		if err.Error() == "only event creator or admin can update this event" {
+		if errors.Is(err, services.ErrEventForbidden) {
 			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
 			return
 		}
@@ -287,18 +437,35 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 		return
 	}
 
+	if req.PriceCents != nil || req.Currency != "" {
+		event, err := h.eventService.GetEvent(id)
+		if err != nil {
+			utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+			return
+		}
+		price := event.PriceCents
+		if req.PriceCents != nil {
+			price = *req.PriceCents
+		}
+		if err := h.eventService.SetPricing(id, price, req.Currency, userID, isAdmin); err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "update_failed", err.Error())
+			return
+		}
+	}
+
 	event, _ := h.eventService.GetEvent(id)
-	utils.RespondSuccess(c, event)
+	utils.RespondSuccess(c, newEventResponse(event))
 }
 
 // DeleteEvent godoc
 // @Summary Delete/Cancel event
-// @Description Cancel an event (creator or admin only)
+// @Description Cancel an event with a reason that is shared with participants (owner, co-host or admin only). Organizers cannot cancel within the cancellation window before the start; admins can.
 // @Tags events
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Event ID"
+// @Param request body CancelEventRequest true "Cancellation reason"
 // @Success 200 {object} utils.SuccessResponse
 // @Failure 400 {object} utils.ErrorResponse
 // @Failure 401 {object} utils.ErrorResponse
@@ -320,11 +487,21 @@ func (h *EventHandler) DeleteEvent(c *gin.Context) {
 		return
 	}
 
-	if err := h.eventService.DeleteEvent(id, userID, isAdmin); err != nil {
-		if err.Error() == "only event creator or admin can delete this event" {
+	var req CancelEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	if err := h.eventService.DeleteEvent(id, userID, isAdmin, req.Reason); err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
 			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
 			return
 		}
+		if errors.Is(err, services.ErrCancellationWindow) {
+			utils.RespondError(c, http.StatusForbidden, "cancellation_window", err.Error())
+			return
+		}
 		utils.RespondError(c, http.StatusBadRequest, "delete_failed", err.Error())
 		return
 	}
@@ -332,9 +509,237 @@ func (h *EventHandler) DeleteEvent(c *gin.Context) {
 	utils.RespondSuccess(c, gin.H{"message": "Event cancelled successfully"})
 }
 
+// CloneEvent godoc
+// @Summary Clone an event
+// @Description Create a new event with the details of an existing one at a new time. The caller becomes the owner and co-hosts are carried over (owner, co-host or admin only).
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body CopyEventRequest true "New occurrence"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/clone [post]
+func (h *EventHandler) CloneEvent(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	opts, ok := bindCopyEventRequest(c)
+	if !ok {
+		return
+	}
+
+	event, err := h.eventService.CloneEvent(id, userID, isAdmin, opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrEventForbidden):
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+		case errors.Is(err, services.ErrEventNotFound):
+			utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+		default:
+			utils.RespondError(c, http.StatusBadRequest, "create_failed", err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{
+		Data: event,
+	})
+}
+
+// bindCopyEventRequest parses a CopyEventRequest, responding with 400 when it is invalid
+func bindCopyEventRequest(c *gin.Context) (services.EventCopyOptions, bool) {
+	var req CopyEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return services.EventCopyOptions{}, false
+	}
+
+	eventTime, local, err := parseEventTime(req.EventTime)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_time_format", eventTimeFormatMessage)
+		return services.EventCopyOptions{}, false
+	}
+
+	opts := services.EventCopyOptions{
+		EventTime:      eventTime,
+		EventTimeLocal: local,
+		Title:          req.Title,
+		Draft:          req.Draft,
+	}
+	if req.PublishAt != "" {
+		publishAt, err := time.Parse(time.RFC3339, req.PublishAt)
+		if err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "invalid_time_format", "Publish time must be in RFC3339 format")
+			return services.EventCopyOptions{}, false
+		}
+		opts.PublishAt = &publishAt
+	}
+	return opts, true
+}
+
+// PublishEvent godoc
+// @Summary Publish a draft
+// @Description Publish a draft now, or with publish_at schedule it to be published later (owner, co-host or admin only)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body PublishEventRequest false "Publish time"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/publish [post]
+func (h *EventHandler) PublishEvent(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var req PublishEventRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+	}
+
+	var event *models.Event
+	if req.PublishAt != "" {
+		publishAt, parseErr := time.Parse(time.RFC3339, req.PublishAt)
+		if parseErr != nil {
+			utils.RespondError(c, http.StatusBadRequest, "invalid_time_format", "Publish time must be in RFC3339 format")
+			return
+		}
+		event, err = h.eventService.SchedulePublish(id, userID, isAdmin, publishAt)
+	} else {
+		event, err = h.eventService.PublishEvent(id, userID, isAdmin)
+	}
+	if err != nil {
+		respondPublishError(c, err)
+		return
+	}
+
+	utils.RespondSuccess(c, newEventResponse(event))
+}
+
+// UnschedulePublish godoc
+// @Summary Cancel scheduled publishing
+// @Description Keep a draft unpublished until it is published manually (owner, co-host or admin only)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/publish [delete]
+func (h *EventHandler) UnschedulePublish(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	if err := h.eventService.UnschedulePublish(id, userID, isAdmin); err != nil {
+		respondPublishError(c, err)
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Scheduled publishing cancelled"})
+}
+
+// ListMyDrafts godoc
+// @Summary List my drafts
+// @Description Unpublished events the caller owns or co-hosts
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/drafts [get]
+func (h *EventHandler) ListMyDrafts(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var query struct {
+		Page  int `form:"page"`
+		Limit int `form:"limit"`
+	}
+	_ = c.ShouldBindQuery(&query)
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	events, total, err := h.eventService.ListDrafts(userID, pagination.GetOffset(), pagination.Limit)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch drafts")
+		return
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, events, &meta)
+}
+
+func respondPublishError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrEventForbidden):
+		utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+	case errors.Is(err, services.ErrEventNotFound):
+		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+	default:
+		utils.RespondError(c, http.StatusBadRequest, "publish_failed", err.Error())
+	}
+}
+
 // JoinEvent godoc
 // @Summary Join an event
-// @Description Join as a participant in an event
+// @Description Join as a participant in an event. Paid events reserve a spot and return a payment to complete before it expires.
 // @Tags events
 // @Accept json
 // @Produce json
@@ -357,17 +762,23 @@ func (h *EventHandler) JoinEvent(c *gin.Context) {
 		return
 	}
 
-	if err := h.eventService.JoinEvent(id, userID); err != nil {
+	payment, err := h.eventService.JoinEvent(id, userID)
+	if err != nil {
 		utils.RespondError(c, http.StatusBadRequest, "join_failed", err.Error())
 		return
 	}
 
+	if payment != nil {
+		utils.RespondSuccess(c, gin.H{"message": "Spot reserved pending payment", "payment": payment})
+		return
+	}
+
 	utils.RespondSuccess(c, gin.H{"message": "Joined event successfully"})
 }
 
 // LeaveEvent godoc
 // @Summary Leave an event
-// @Description Remove yourself as a participant from an event
+// @Description Remove yourself as a participant from an event, or give up a reserved spot and close its open checkout
 // @Tags events
 // @Accept json
 // @Produce json
@@ -437,3 +848,522 @@ func (h *EventHandler) SwipeEvent(c *gin.Context) {
 
 	utils.RespondSuccess(c, gin.H{"message": "Swipe recorded successfully"})
 }
+
+// ListCoHosts godoc
+// @Summary List event co-hosts
+// @Description Get the co-hosts of an event (name and avatar only); drafts and hidden events are only visible to their organizers and admins
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/cohosts [get]
+func (h *EventHandler) ListCoHosts(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	cohosts, err := h.eventService.ListCoHosts(id, viewer, isAdmin)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+		return
+	}
+
+	utils.RespondSuccess(c, cohostsResponse(cohosts))
+}
+
+// AddCoHost godoc
+// @Summary Add a co-host
+// @Description Give a user edit and participant management rights on an event (owner or admin only)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body CoHostRequest true "Co-host"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/cohosts [post]
+func (h *EventHandler) AddCoHost(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var req CoHostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	if err := h.eventService.AddCoHost(id, req.UserID, userID, isAdmin); err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "cohost_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Co-host added successfully"})
+}
+
+// RemoveCoHost godoc
+// @Summary Remove a co-host
+// @Description Remove a co-host from an event (owner or admin, or the co-host themselves)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param userId path string true "Co-host user ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/cohosts/{userId} [delete]
+func (h *EventHandler) RemoveCoHost(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	cohostID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := h.eventService.RemoveCoHost(id, cohostID, userID, isAdmin); err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "cohost_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Co-host removed successfully"})
+}
+
+// TransferOwnership godoc
+// @Summary Transfer event ownership
+// @Description Hand the event to another user (owner or admin only); the previous owner stays on as co-host unless keep_as_cohost is false
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body TransferOwnershipRequest true "New owner"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/transfer [post]
+func (h *EventHandler) TransferOwnership(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var req TransferOwnershipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	keepAsCoHost := true
+	if req.KeepAsCoHost != nil {
+		keepAsCoHost = *req.KeepAsCoHost
+	}
+
+	if err := h.eventService.TransferOwnership(id, req.UserID, userID, isAdmin, keepAsCoHost); err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "transfer_failed", err.Error())
+		return
+	}
+
+	event, _ := h.eventService.GetEvent(id)
+	utils.RespondSuccess(c, newEventResponse(event))
+}
+
+// ListParticipants godoc
+// @Summary List event participants
+// @Description Get the event roster with join time and status. Organizers also see removed and banned users with reasons.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/participants [get]
+func (h *EventHandler) ListParticipants(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	participants, isOrganizer, err := h.eventService.ListParticipants(id, userID, isAdmin)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+		return
+	}
+
+	roster := make([]gin.H, 0, len(participants))
+	for _, p := range participants {
+		entry := gin.H{
+			"user_id":       p.UserID,
+			"status":        p.Status,
+			"joined_at":     p.JoinedAt,
+			"checked_in_at": p.CheckedInAt,
+		}
+		if p.User != nil {
+			entry["name"] = p.User.Name
+		}
+		if isOrganizer && p.Status != models.ParticipantStatusJoined {
+			entry["removal_reason"] = p.RemovalReason
+			entry["removed_by"] = p.RemovedBy
+			entry["removed_at"] = p.RemovedAt
+		}
+		roster = append(roster, entry)
+	}
+
+	utils.RespondSuccess(c, roster)
+}
+
+// GetMyParticipation godoc
+// @Summary Get my participation
+// @Description Get the current user's participation status for an event, including the reason if they were removed or banned
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/participants/me [get]
+func (h *EventHandler) GetMyParticipation(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	participant, err := h.eventService.GetParticipation(id, userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "not_participant", "You are not a participant of this event")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{
+		"event_id":       participant.EventID,
+		"status":         participant.Status,
+		"joined_at":      participant.JoinedAt,
+		"removal_reason": participant.RemovalReason,
+		"removed_at":     participant.RemovedAt,
+	})
+}
+
+// RemoveParticipant godoc
+// @Summary Remove a participant
+// @Description Kick a participant from the event with a reason shown to them (owner, co-host or admin only)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param userId path string true "Participant user ID"
+// @Param request body ParticipantActionRequest true "Reason"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/participants/{userId}/remove [post]
+func (h *EventHandler) RemoveParticipant(c *gin.Context) {
+	h.participantAction(c, h.eventService.RemoveParticipant, "Participant removed successfully")
+}
+
+// BanParticipant godoc
+// @Summary Ban a user from the event
+// @Description Remove a participant and prevent them from rejoining (owner, co-host or admin only)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param userId path string true "User ID"
+// @Param request body ParticipantActionRequest true "Reason"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/participants/{userId}/ban [post]
+func (h *EventHandler) BanParticipant(c *gin.Context) {
+	h.participantAction(c, h.eventService.BanParticipant, "User banned from event successfully")
+}
+
+// UnbanParticipant godoc
+// @Summary Lift an event ban
+// @Description Allow a banned user to join the event again (owner, co-host or admin only)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param userId path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/participants/{userId}/ban [delete]
+func (h *EventHandler) UnbanParticipant(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	targetID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := h.eventService.UnbanParticipant(id, targetID, userID, isAdmin); err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "unban_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Ban lifted successfully"})
+}
+
+// participantAction runs an organizer roster action that takes a reason
+// CheckInParticipant godoc
+// @Summary Check in a participant
+// @Description Record that a participant showed up; cost splits are shared among checked-in participants (owner, co-host or admin only)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param userId path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/participants/{userId}/check-in [post]
+func (h *EventHandler) CheckInParticipant(c *gin.Context) {
+	h.setCheckedIn(c, true, "Participant checked in")
+}
+
+// UndoCheckIn godoc
+// @Summary Undo a check-in
+// @Description Clear a participant's check-in (owner, co-host or admin only)
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param userId path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/participants/{userId}/check-in [delete]
+func (h *EventHandler) UndoCheckIn(c *gin.Context) {
+	h.setCheckedIn(c, false, "Check-in removed")
+}
+
+func (h *EventHandler) setCheckedIn(c *gin.Context, checkedIn bool, message string) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	targetID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := h.eventService.SetCheckedIn(id, targetID, userID, isAdmin, checkedIn); err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "check_in_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": message})
+}
+
+func (h *EventHandler) participantAction(
+	c *gin.Context,
+	action func(eventID, targetID, userID uuid.UUID, isAdmin bool, reason string) error,
+	message string,
+) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	targetID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	var req ParticipantActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	if err := action(id, targetID, userID, isAdmin, req.Reason); err != nil {
+		if errors.Is(err, services.ErrEventForbidden) || errors.Is(err, services.ErrCoHostProtected) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "participant_action_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": message})
+}
+
+// eventResponse is an event as returned to its viewers; the organizers preloaded on
+// it are reduced to what other users may see of them
+type eventResponse struct {
+	*models.Event
+	Creator gin.H   `json:"creator,omitempty"`
+	CoHosts []gin.H `json:"cohosts,omitempty"`
+}
+
+func newEventResponse(event *models.Event) eventResponse {
+	response := eventResponse{
+		Event:   event,
+		CoHosts: cohostsResponse(event.CoHosts),
+	}
+	if event.Creator != nil {
+		response.Creator = publicUserResponse(event.Creator)
+	}
+	return response
+}
+
+// cohostsResponse exposes co-host names and avatars without the rest of the user record
+func cohostsResponse(cohosts []models.EventCoHost) []gin.H {
+	result := make([]gin.H, 0, len(cohosts))
+	for _, cohost := range cohosts {
+		entry := gin.H{
+			"user_id":  cohost.UserID,
+			"added_at": cohost.CreatedAt,
+		}
+		if cohost.User != nil {
+			entry["name"] = cohost.User.Name
+			entry["avatar_url"] = cohost.User.AvatarURL
+		}
+		result = append(result, entry)
+	}
+	return result
+}
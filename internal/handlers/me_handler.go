@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"net/http"
+	"net/url"
 	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
 	"playspotter/internal/services"
 	"playspotter/internal/utils"
 
@@ -20,8 +22,20 @@ func NewMeHandler(userService *services.UserService) *MeHandler {
 }
 
 type UpdateMeRequest struct {
-	Name     string `json:"name"`
-	Password string `json:"password" binding:"omitempty,min=8"`
+	Name              string   `json:"name"`
+	Password          string   `json:"password" binding:"omitempty,min=8"`
+	AvatarURL         *string  `json:"avatar_url" binding:"omitempty,max=500"`
+	Bio               *string  `json:"bio" binding:"omitempty,max=500"`
+	FavoriteSports    []string `json:"favorite_sports" binding:"omitempty,max=10"`
+	HomeArea          *string  `json:"home_area" binding:"omitempty,max=120"`
+	ProfileVisibility string   `json:"profile_visibility" binding:"omitempty,oneof=public followers private"`
+	ShowHomeArea      *bool    `json:"show_home_area"`
+	ShowStats         *bool    `json:"show_stats"`
+}
+
+type SetSkillRequest struct {
+	SportType string `json:"sport_type" binding:"required,max=50"`
+	Level     int    `json:"level" binding:"required,min=1,max=5"`
 }
 
 // GetMe godoc
@@ -48,19 +62,12 @@ func (h *MeHandler) GetMe(c *gin.Context) {
 		return
 	}
 
-	utils.RespondSuccess(c, gin.H{
-		"id":         user.ID,
-		"name":       user.Name,
-		"email":      user.Email,
-		"role":       user.Role,
-		"created_at": user.CreatedAt,
-		"updated_at": user.UpdatedAt,
-	})
+	utils.RespondSuccess(c, meResponse(user))
 }
 
 // UpdateMe godoc
 // @Summary Update current user
-// @Description Update authenticated user information
+// @Description Update your name, password, profile (avatar_url, bio, favorite_sports, home_area) and privacy settings (profile_visibility, show_home_area, show_stats). Empty strings clear profile fields.
 // @Tags user
 // @Accept json
 // @Produce json
@@ -84,17 +91,143 @@ func (h *MeHandler) UpdateMe(c *gin.Context) {
 		return
 	}
 
-	if err := h.userService.UpdateUser(userID, req.Name, req.Password); err != nil {
-		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to update user")
+	if req.AvatarURL != nil && *req.AvatarURL != "" && !isHTTPURL(*req.AvatarURL) {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", "avatar_url must be an http or https URL")
 		return
 	}
 
-	user, _ := h.userService.GetUser(userID)
-	utils.RespondSuccess(c, gin.H{
-		"id":         user.ID,
-		"name":       user.Name,
-		"email":      user.Email,
-		"role":       user.Role,
-		"updated_at": user.UpdatedAt,
+	err := h.userService.UpdateUser(userID, services.UserUpdate{
+		Name:              req.Name,
+		Password:          req.Password,
+		AvatarURL:         req.AvatarURL,
+		Bio:               req.Bio,
+		FavoriteSports:    req.FavoriteSports,
+		HomeArea:          req.HomeArea,
+		ProfileVisibility: req.ProfileVisibility,
+		ShowHomeArea:      req.ShowHomeArea,
+		ShowStats:         req.ShowStats,
 	})
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "update_failed", err.Error())
+		return
+	}
+
+	user, _ := h.userService.GetUser(userID)
+	utils.RespondSuccess(c, meResponse(user))
+}
+
+// meResponse is the signed-in user's own account, including the email and privacy settings
+func meResponse(user *models.User) gin.H {
+	favoriteSports := user.FavoriteSports
+	if favoriteSports == nil {
+		favoriteSports = []string{}
+	}
+	return gin.H{
+		"id":                 user.ID,
+		"name":               user.Name,
+		"email":              user.Email,
+		"role":               user.Role,
+		"avatar_url":         user.AvatarURL,
+		"bio":                user.Bio,
+		"favorite_sports":    favoriteSports,
+		"home_area":          user.HomeArea,
+		"profile_visibility": user.ProfileVisibility,
+		"show_home_area":     user.ShowHomeArea,
+		"show_stats":         user.ShowStats,
+		"created_at":         user.CreatedAt,
+		"updated_at":         user.UpdatedAt,
+	}
+}
+
+// isHTTPURL reports whether the value is an absolute http or https URL
+func isHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// ListMySkills godoc
+// @Summary List my sport skills
+// @Description Get the authenticated user's self-assessed skill level per sport
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/skills [get]
+func (h *MeHandler) ListMySkills(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	skills, err := h.userService.ListSportSkills(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch skills")
+		return
+	}
+
+	utils.RespondSuccess(c, skills)
+}
+
+// SetMySkill godoc
+// @Summary Set my sport skill
+// @Description Set the authenticated user's skill level (1-5) for a sport, used for team balancing
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SetSkillRequest true "Skill level"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/skills [put]
+func (h *MeHandler) SetMySkill(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req SetSkillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	skill, err := h.userService.SetSportSkill(userID, req.SportType, req.Level)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "skill_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, skill)
+}
+
+// DeleteMySkill godoc
+// @Summary Delete my sport skill
+// @Description Remove the authenticated user's skill level for a sport
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param sport path string true "Sport slug"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/skills/{sport} [delete]
+func (h *MeHandler) DeleteMySkill(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	if err := h.userService.DeleteSportSkill(userID, c.Param("sport")); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "skill_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Skill removed successfully"})
 }
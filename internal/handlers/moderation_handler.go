@@ -0,0 +1,450 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ModerationHandler struct {
+	moderationService *services.ModerationService
+}
+
+func NewModerationHandler(moderationService *services.ModerationService) *ModerationHandler {
+	return &ModerationHandler{moderationService: moderationService}
+}
+
+type ResolveReportRequest struct {
+	Action       string     `json:"action" binding:"required,oneof=warn hide_event hide_comment suspend_user ban_user dismiss"`
+	Note         string     `json:"note" binding:"max=1000"`
+	SuspendUntil *time.Time `json:"suspend_until" binding:"required_if=Action suspend_user"`
+}
+
+type HideEventRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type SuspendUserRequest struct {
+	Until  time.Time `json:"until" binding:"required"`
+	Reason string    `json:"reason" binding:"required,max=500"`
+}
+
+type AccountReasonRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// GetReport godoc
+// @Summary Get a report (admin only)
+// @Description Get a report from the moderation queue with its reporter
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/reports/{id} [get]
+func (h *ModerationHandler) GetReport(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid report ID")
+		return
+	}
+
+	report, err := h.moderationService.GetReport(id)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "report_not_found", "Report not found")
+		return
+	}
+
+	utils.RespondSuccess(c, report)
+}
+
+// ClaimReport godoc
+// @Summary Claim a report (admin only)
+// @Description Take an open report so other admins know you are handling it
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /admin/reports/{id}/claim [post]
+func (h *ModerationHandler) ClaimReport(c *gin.Context) {
+	h.claim(c, h.moderationService.ClaimReport)
+}
+
+// ReleaseReport godoc
+// @Summary Release a claimed report (admin only)
+// @Description Put a report you claimed back in the queue
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /admin/reports/{id}/claim [delete]
+func (h *ModerationHandler) ReleaseReport(c *gin.Context) {
+	h.claim(c, h.moderationService.ReleaseReport)
+}
+
+// ResolveReport godoc
+// @Summary Resolve a report (admin only)
+// @Description Close a report with an action: warn the user (note required), hide the reported event or comment, suspend the user until suspend_until, ban the user, or dismiss the report. Every action except dismiss also closes the other reports waiting about the same item. The action is recorded with the admin who took it.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID"
+// @Param request body ResolveReportRequest true "Decision"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /admin/reports/{id}/resolve [post]
+func (h *ModerationHandler) ResolveReport(c *gin.Context) {
+	adminID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid report ID")
+		return
+	}
+
+	var req ResolveReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	report, err := h.moderationService.ResolveReport(id, adminID, services.ModerationDecision{
+		Action:       req.Action,
+		Note:         req.Note,
+		SuspendUntil: req.SuspendUntil,
+	})
+	if err != nil {
+		respondModerationError(c, err, "resolve_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, report)
+}
+
+// HideEvent godoc
+// @Summary Hide an event (admin only)
+// @Description Take an event out of the feed with a reason. Only its organizers and admins can still open it, and nobody can join it. The organizers are notified.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body HideEventRequest true "Reason"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/events/{id}/hide [post]
+func (h *ModerationHandler) HideEvent(c *gin.Context) {
+	adminID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var req HideEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	event, err := h.moderationService.HideEvent(id, adminID, req.Reason)
+	if err != nil {
+		if errors.Is(err, services.ErrEventNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "hide_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, event)
+}
+
+// UnhideEvent godoc
+// @Summary Unhide an event (admin only)
+// @Description Put an event hidden by a moderator back in the feed
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/events/{id}/hide [delete]
+func (h *ModerationHandler) UnhideEvent(c *gin.Context) {
+	adminID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	event, err := h.moderationService.UnhideEvent(id, adminID)
+	if err != nil {
+		if errors.Is(err, services.ErrEventNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "unhide_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, event)
+}
+
+// SuspendUser godoc
+// @Summary Suspend a user (admin only)
+// @Description Lock a user out until the given time with a reason. They are signed out everywhere, and their events starting before then go to a co-host or are cancelled. Admins cannot be suspended.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body SuspendUserRequest true "Suspension"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/users/{id}/suspend [post]
+func (h *ModerationHandler) SuspendUser(c *gin.Context) {
+	var req SuspendUserRequest
+	h.changeAccount(c, &req, func(id, adminID uuid.UUID) (*models.User, error) {
+		return h.moderationService.SuspendUser(id, adminID, req.Until, req.Reason)
+	})
+}
+
+// BanUser godoc
+// @Summary Ban a user (admin only)
+// @Description Lock a user out until the ban is lifted, with a reason. They are signed out everywhere, and all their upcoming events go to a co-host or are cancelled. Admins cannot be banned.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body AccountReasonRequest true "Reason"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/users/{id}/ban [post]
+func (h *ModerationHandler) BanUser(c *gin.Context) {
+	var req AccountReasonRequest
+	h.changeAccount(c, &req, func(id, adminID uuid.UUID) (*models.User, error) {
+		return h.moderationService.BanUser(id, adminID, req.Reason)
+	})
+}
+
+// UnbanUser godoc
+// @Summary Lift a ban or suspension (admin only)
+// @Description Let a banned or suspended user back in, with a reason. Events that were handed off or cancelled stay that way.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body AccountReasonRequest true "Reason"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/users/{id}/unban [post]
+func (h *ModerationHandler) UnbanUser(c *gin.Context) {
+	var req AccountReasonRequest
+	h.changeAccount(c, &req, func(id, adminID uuid.UUID) (*models.User, error) {
+		return h.moderationService.UnbanUser(id, adminID, req.Reason)
+	})
+}
+
+// ListActions godoc
+// @Summary List moderation actions (admin only)
+// @Description Get the record of moderation actions and the admins who took them, newest first
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param admin_id query string false "Admin who took the action"
+// @Param user_id query string false "User the action was about"
+// @Param report_id query string false "Report the action closed"
+// @Param target_type query string false "user, event or comment"
+// @Param target_id query string false "Target ID"
+// @Param action query string false "warn, hide_event, unhide_event, hide_comment, suspend_user or dismiss"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /admin/moderation-actions [get]
+func (h *ModerationHandler) ListActions(c *gin.Context) {
+	var query struct {
+		AdminID    string `form:"admin_id"`
+		UserID     string `form:"user_id"`
+		ReportID   string `form:"report_id"`
+		TargetType string `form:"target_type"`
+		TargetID   string `form:"target_id"`
+		Action     string `form:"action"`
+		Page       int    `form:"page"`
+		Limit      int    `form:"limit"`
+	}
+	_ = c.ShouldBindQuery(&query)
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	filter := repositories.ModerationActionFilter{
+		TargetType: query.TargetType,
+		Action:     query.Action,
+		Offset:     pagination.GetOffset(),
+		Limit:      pagination.Limit,
+	}
+	ids := []struct {
+		value string
+		dest  **uuid.UUID
+		name  string
+	}{
+		{query.AdminID, &filter.AdminID, "admin_id"},
+		{query.UserID, &filter.UserID, "user_id"},
+		{query.ReportID, &filter.ReportID, "report_id"},
+		{query.TargetID, &filter.TargetID, "target_id"},
+	}
+	for _, id := range ids {
+		if id.value == "" {
+			continue
+		}
+		parsed, err := uuid.Parse(id.value)
+		if err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid "+id.name)
+			return
+		}
+		*id.dest = &parsed
+	}
+
+	actions, total, err := h.moderationService.ListActions(filter)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch moderation actions")
+		return
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, actions, &meta)
+}
+
+func (h *ModerationHandler) claim(c *gin.Context, change func(uuid.UUID, uuid.UUID) (*models.Report, error)) {
+	adminID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid report ID")
+		return
+	}
+
+	report, err := change(id, adminID)
+	if err != nil {
+		respondModerationError(c, err, "claim_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, report)
+}
+
+// changeAccount binds req and applies an account status change to the user in the path
+func (h *ModerationHandler) changeAccount(c *gin.Context, req interface{}, change func(uuid.UUID, uuid.UUID) (*models.User, error)) {
+	adminID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := c.ShouldBindJSON(req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	user, err := change(id, adminID)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "user_not_found", "User not found")
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "account_update_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, user)
+}
+
+func respondModerationError(c *gin.Context, err error, code string) {
+	switch {
+	case errors.Is(err, services.ErrReportNotFound):
+		utils.RespondError(c, http.StatusNotFound, "report_not_found", "Report not found")
+	case errors.Is(err, services.ErrReportClaimed):
+		utils.RespondError(c, http.StatusConflict, "report_claimed", err.Error())
+	case errors.Is(err, services.ErrReportTargetNotFound), errors.Is(err, services.ErrUserNotFound):
+		utils.RespondError(c, http.StatusNotFound, "target_not_found", err.Error())
+	default:
+		utils.RespondError(c, http.StatusBadRequest, code, err.Error())
+	}
+}
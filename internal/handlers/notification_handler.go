@@ -0,0 +1,314 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type NotificationHandler struct {
+	notificationService *services.NotificationService
+	pushService         *services.PushService
+}
+
+func NewNotificationHandler(notificationService *services.NotificationService, pushService *services.PushService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		pushService:         pushService,
+	}
+}
+
+type MarkNotificationsReadRequest struct {
+	IDs []uuid.UUID `json:"ids"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	InApp map[string]bool `json:"in_app"`
+	Push  map[string]bool `json:"push"`
+}
+
+type RegisterDeviceRequest struct {
+	Token    string `json:"token" binding:"required,max=4096"`
+	Platform string `json:"platform" binding:"required,oneof=ios android web"`
+}
+
+// ListNotifications godoc
+// @Summary List my notifications
+// @Description Get your notifications, newest first, with the number of unread ones
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param unread query bool false "Only unread notifications"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/notifications [get]
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var query struct {
+		Unread bool `form:"unread"`
+		Page   int  `form:"page"`
+		Limit  int  `form:"limit"`
+	}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	notifications, total, err := h.notificationService.List(userID, query.Unread, pagination.GetOffset(), pagination.Limit)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch notifications")
+		return
+	}
+
+	unread, err := h.notificationService.UnreadCount(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to count notifications")
+		return
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, gin.H{
+		"notifications": notifications,
+		"unread_count":  unread,
+	}, &meta)
+}
+
+// GetUnreadCount godoc
+// @Summary Count unread notifications
+// @Description Get the number of unread notifications, e.g. for a badge
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/notifications/unread-count [get]
+func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	unread, err := h.notificationService.UnreadCount(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to count notifications")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"unread_count": unread})
+}
+
+// MarkRead godoc
+// @Summary Mark notifications as read
+// @Description Mark the listed notifications as read, or all of them when no IDs are sent
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body MarkNotificationsReadRequest false "Notification IDs"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/notifications/read [post]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req MarkNotificationsReadRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+	}
+
+	marked, err := h.notificationService.MarkRead(userID, req.IDs)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to update notifications")
+		return
+	}
+
+	unread, err := h.notificationService.UnreadCount(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to count notifications")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"marked": marked, "unread_count": unread})
+}
+
+// GetPreferences godoc
+// @Summary Get notification preferences
+// @Description Get whether each notification type is delivered to your inbox and pushed to your devices
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/notification-preferences [get]
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	prefs, err := h.notificationService.Preferences(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch preferences")
+		return
+	}
+
+	utils.RespondSuccess(c, prefs)
+}
+
+// UpdatePreferences godoc
+// @Summary Update notification preferences
+// @Description Switch inbox or push delivery of notification types on or off, e.g. {"in_app": {"participant_joined": false}, "push": {"event_full": true}}
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UpdateNotificationPreferencesRequest true "Preferences by type"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/notification-preferences [put]
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	prefs, err := h.notificationService.SetPreferences(userID, req.InApp, req.Push)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "update_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, prefs)
+}
+
+// ListDevices godoc
+// @Summary List my devices
+// @Description Get the devices registered for push notifications
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/devices [get]
+func (h *NotificationHandler) ListDevices(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	devices, err := h.pushService.ListDevices(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch devices")
+		return
+	}
+
+	utils.RespondSuccess(c, devices)
+}
+
+// RegisterDevice godoc
+// @Summary Register a device for push notifications
+// @Description Store the push token of this device; registering a known token again refreshes it
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body RegisterDeviceRequest true "Device token"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/devices [post]
+func (h *NotificationHandler) RegisterDevice(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req RegisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	device, err := h.pushService.RegisterDevice(userID, req.Token, req.Platform)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "register_failed", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{Data: device})
+}
+
+// RemoveDevice godoc
+// @Summary Unregister a device
+// @Description Stop push notifications to one of your devices
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Device ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /me/devices/{id} [delete]
+func (h *NotificationHandler) RemoveDevice(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid device ID")
+		return
+	}
+
+	if err := h.pushService.RemoveDevice(userID, id); err != nil {
+		if errors.Is(err, services.ErrDeviceNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "device_not_found", "Device not found")
+			return
+		}
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to remove device")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Device removed"})
+}
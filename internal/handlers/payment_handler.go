@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/payments"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentHandler struct {
+	eventService *services.EventService
+	fakeProvider *payments.FakeProvider
+}
+
+// NewPaymentHandler creates the payment handler; fakeProvider is nil unless the fake
+// provider is configured, which disables the checkout simulation endpoint
+func NewPaymentHandler(eventService *services.EventService, fakeProvider *payments.FakeProvider) *PaymentHandler {
+	return &PaymentHandler{
+		eventService: eventService,
+		fakeProvider: fakeProvider,
+	}
+}
+
+type FakeCheckoutRequest struct {
+	Outcome string `json:"outcome" binding:"required,oneof=succeeded failed"`
+}
+
+// Webhook godoc
+// @Summary Payment provider webhook
+// @Description Receive signed payment callbacks; repeated deliveries are processed once and callbacks for unknown payments are acknowledged
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /payments/webhook [post]
+func (h *PaymentHandler) Webhook(c *gin.Context) {
+	payload, err := c.GetRawData()
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_payload", "Could not read request body")
+		return
+	}
+
+	if err := h.eventService.HandlePaymentWebhook(payload, c.Request.Header); err != nil {
+		if errors.Is(err, payments.ErrInvalidSignature) {
+			utils.RespondError(c, http.StatusUnauthorized, "invalid_signature", err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrPaymentsDisabled) {
+			utils.RespondError(c, http.StatusNotFound, "not_found", err.Error())
+			return
+		}
+		// The provider retries non-2xx responses, and the delivery was rolled back
+		utils.RespondError(c, http.StatusInternalServerError, "webhook_failed", "Failed to process webhook")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"received": true})
+}
+
+// FakeCheckout godoc
+// @Summary Complete a fake checkout
+// @Description Simulate the user paying (or abandoning) their own checkout with the fake provider; only available when PAYMENT_PROVIDER=fake
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param ref path string true "Provider payment reference"
+// @Param request body FakeCheckoutRequest true "Checkout outcome"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /payments/fake/{ref} [post]
+func (h *PaymentHandler) FakeCheckout(c *gin.Context) {
+	if h.fakeProvider == nil {
+		utils.RespondError(c, http.StatusNotFound, "not_found", "Fake payment provider is not enabled")
+		return
+	}
+
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req FakeCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	payment, err := h.eventService.FindCheckout(c.Param("ref"), userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "not_found", err.Error())
+		return
+	}
+
+	eventType := payments.EventPaymentSucceeded
+	if req.Outcome == "failed" {
+		eventType = payments.EventPaymentFailed
+	}
+
+	payload, header, err := h.fakeProvider.SimulateWebhook(payment.ProviderRef, eventType)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to build webhook")
+		return
+	}
+
+	if err := h.eventService.HandlePaymentWebhook(payload, header); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "webhook_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Checkout " + req.Outcome})
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/realtime"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// streamHeartbeat keeps idle connections from being closed by proxies
+const streamHeartbeat = 25 * time.Second
+
+type RealtimeHandler struct {
+	hub            *realtime.Hub
+	eventService   *services.EventService
+	commentService *services.CommentService
+}
+
+func NewRealtimeHandler(hub *realtime.Hub, eventService *services.EventService, commentService *services.CommentService) *RealtimeHandler {
+	return &RealtimeHandler{
+		hub:            hub,
+		eventService:   eventService,
+		commentService: commentService,
+	}
+}
+
+type FeedStreamQuery struct {
+	Lat         float64 `form:"lat" binding:"required,min=-90,max=90"`
+	Lng         float64 `form:"lng" binding:"required,min=-180,max=180"`
+	MaxDistance float64 `form:"max_distance_km" binding:"omitempty,min=0,max=500"`
+}
+
+// StreamEvent godoc
+// @Summary Stream event updates
+// @Description Server-Sent Events stream of joins, leaves, status changes, edits and comments for one event. Each SSE event is named after the update type. Comments of participants-only events are only streamed to participants and organizers; the stream ends when the user loses access to them. The token may be passed as access_token since EventSource cannot set headers.
+// @Tags realtime
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param access_token query string false "Access token when the Authorization header cannot be set"
+// @Success 200 {object} realtime.Update
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/stream [get]
+func (h *RealtimeHandler) StreamEvent(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	event, err := h.eventService.ViewEvent(id, &userID, isAdmin)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+		return
+	}
+
+	sub := h.hub.SubscribeEvent(event.ID, h.commentService.CanView(event, &userID, isAdmin))
+	h.stream(c, sub, gin.H{"event_id": event.ID, "status": event.Status}, func(update realtime.Update) bool {
+		// The user may have left or been removed since subscribing, so private
+		// updates are only sent while they can still see the comments
+		if !update.Private {
+			return true
+		}
+		current, err := h.eventService.ViewEvent(id, &userID, isAdmin)
+		return err == nil && h.commentService.CanView(current, &userID, isAdmin)
+	})
+}
+
+// StreamFeed godoc
+// @Summary Stream feed updates
+// @Description Server-Sent Events stream of joins, leaves, status changes and edits for every event within max_distance_km (default 25) of the given point. Comments are not included; subscribe to an event for those.
+// @Tags realtime
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param max_distance_km query number false "Radius in km (max 500)"
+// @Param access_token query string false "Access token when the Authorization header cannot be set"
+// @Success 200 {object} realtime.Update
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /stream/feed [get]
+func (h *RealtimeHandler) StreamFeed(c *gin.Context) {
+	var query FeedStreamQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	if query.MaxDistance == 0 {
+		query.MaxDistance = 25
+	}
+
+	sub := h.hub.SubscribeArea(realtime.Area{
+		Latitude:   query.Lat,
+		Longitude:  query.Lng,
+		DistanceKm: query.MaxDistance,
+	})
+	h.stream(c, sub, gin.H{"lat": query.Lat, "lng": query.Lng, "max_distance_km": query.MaxDistance}, nil)
+}
+
+// stream writes the subscription as Server-Sent Events until the client goes
+// away or the subscription is closed (slow client or server shutdown). When allow
+// is set and rejects an update the stream ends, so the client reconnects with the
+// access it has now.
+func (h *RealtimeHandler) stream(c *gin.Context, sub *realtime.Subscription, ready gin.H, allow func(update realtime.Update) bool) {
+	defer sub.Close()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	c.SSEvent("ready", ready)
+	c.Writer.Flush()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return false
+			}
+			if allow != nil && !allow(update) {
+				return false
+			}
+			c.SSEvent(update.Type, update)
+			return true
+		case <-heartbeat.C:
+			c.SSEvent("ping", gin.H{"at": time.Now().UTC()})
+			return true
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
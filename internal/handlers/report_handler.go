@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReportHandler struct {
+	reportService *services.ReportService
+}
+
+func NewReportHandler(reportService *services.ReportService) *ReportHandler {
+	return &ReportHandler{reportService: reportService}
+}
+
+type CreateReportRequest struct {
+	TargetType string    `json:"target_type" binding:"required,oneof=user event comment"`
+	TargetID   uuid.UUID `json:"target_id" binding:"required"`
+	Category   string    `json:"category" binding:"required,oneof=spam harassment hate violence inappropriate scam other"`
+	Details    *string   `json:"details" binding:"omitempty,max=1000"`
+}
+
+// CreateReport godoc
+// @Summary Report a user, event or comment
+// @Description Report abuse with a category (spam, harassment, hate, violence, inappropriate, scam, other) and optional details; reports go to the admin moderation queue
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateReportRequest true "Report"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /reports [post]
+func (h *ReportHandler) CreateReport(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req CreateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	report := &models.Report{
+		ReporterID: userID,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Category:   req.Category,
+		Details:    req.Details,
+	}
+	if err := h.reportService.CreateReport(report); err != nil {
+		switch {
+		case errors.Is(err, services.ErrReportTargetNotFound):
+			utils.RespondError(c, http.StatusNotFound, "target_not_found", err.Error())
+		case errors.Is(err, services.ErrAlreadyReported):
+			utils.RespondError(c, http.StatusConflict, "already_reported", err.Error())
+		default:
+			utils.RespondError(c, http.StatusBadRequest, "report_failed", err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{
+		Data: report,
+	})
+}
+
+// AdminListReports godoc
+// @Summary List reports (admin only)
+// @Description Get the moderation queue, oldest first. Use status=claimed with claimed_by to see the reports an admin is working on.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Report status" default(open)
+// @Param target_type query string false "user, event or comment"
+// @Param category query string false "Report category"
+// @Param claimed_by query string false "Admin holding the report"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /admin/reports [get]
+func (h *ReportHandler) AdminListReports(c *gin.Context) {
+	var query struct {
+		Status     string `form:"status"`
+		TargetType string `form:"target_type"`
+		Category   string `form:"category"`
+		ClaimedBy  string `form:"claimed_by"`
+		Page       int    `form:"page"`
+		Limit      int    `form:"limit"`
+	}
+	_ = c.ShouldBindQuery(&query)
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	if query.Status == "" {
+		query.Status = models.ReportStatusOpen
+	}
+
+	filter := repositories.ReportFilter{
+		Status:     query.Status,
+		TargetType: query.TargetType,
+		Category:   query.Category,
+		Offset:     pagination.GetOffset(),
+		Limit:      pagination.Limit,
+	}
+	if query.ClaimedBy != "" {
+		claimedBy, err := uuid.Parse(query.ClaimedBy)
+		if err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid claimed_by")
+			return
+		}
+		filter.ClaimedBy = &claimedBy
+	}
+
+	reports, total, err := h.reportService.ListReports(filter)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch reports")
+		return
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, reports, &meta)
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"net/http"
+	"playspotter/internal/models"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SportHandler struct {
+	sportService *services.SportService
+}
+
+func NewSportHandler(sportService *services.SportService) *SportHandler {
+	return &SportHandler{
+		sportService: sportService,
+	}
+}
+
+type CreateSportRequest struct {
+	Slug            string            `json:"slug" binding:"required,max=50"`
+	Names           map[string]string `json:"names" binding:"required"`
+	Icon            *string           `json:"icon"`
+	DefaultTeamSize *int              `json:"default_team_size" binding:"omitempty,min=1"`
+	Aliases         []string          `json:"aliases"`
+}
+
+type UpdateSportRequest struct {
+	Slug            string            `json:"slug" binding:"omitempty,max=50"`
+	Names           map[string]string `json:"names"`
+	Icon            *string           `json:"icon"`
+	DefaultTeamSize *int              `json:"default_team_size" binding:"omitempty,min=1"`
+	Aliases         []string          `json:"aliases"`
+	IsActive        *bool             `json:"is_active"`
+}
+
+// ListSports godoc
+// @Summary List sports
+// @Description Get the catalog of active sports used for event sport_type
+// @Tags sports
+// @Accept json
+// @Produce json
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 500 {object} utils.ErrorResponse
+// @Router /sports [get]
+func (h *SportHandler) ListSports(c *gin.Context) {
+	sports, err := h.sportService.ListSports(false)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch sports")
+		return
+	}
+
+	utils.RespondSuccess(c, sports)
+}
+
+// AdminListSports godoc
+// @Summary List all sports (admin only)
+// @Description Get the full sports catalog including inactive entries
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /admin/sports [get]
+func (h *SportHandler) AdminListSports(c *gin.Context) {
+	sports, err := h.sportService.ListSports(true)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch sports")
+		return
+	}
+
+	utils.RespondSuccess(c, sports)
+}
+
+// CreateSport godoc
+// @Summary Create sport (admin only)
+// @Description Add a sport to the catalog
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateSportRequest true "Sport details"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /admin/sports [post]
+func (h *SportHandler) CreateSport(c *gin.Context) {
+	var req CreateSportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	sport := &models.Sport{
+		Slug:            req.Slug,
+		Names:           req.Names,
+		Icon:            req.Icon,
+		DefaultTeamSize: req.DefaultTeamSize,
+		Aliases:         req.Aliases,
+	}
+
+	if err := h.sportService.CreateSport(sport); err != nil {
+		if err.Error() == "sport slug already exists" {
+			utils.RespondError(c, http.StatusConflict, "sport_exists", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "create_failed", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{
+		Data: sport,
+	})
+}
+
+// UpdateSport godoc
+// @Summary Update sport (admin only)
+// @Description Update a catalog entry; renaming the slug also updates existing events
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Sport ID"
+// @Param request body UpdateSportRequest true "Update details"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /admin/sports/{id} [put]
+func (h *SportHandler) UpdateSport(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid sport ID")
+		return
+	}
+
+	var req UpdateSportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	if _, err := h.sportService.GetSport(id); err != nil {
+		utils.RespondError(c, http.StatusNotFound, "sport_not_found", "Sport not found")
+		return
+	}
+
+	updates := &models.Sport{
+		Slug:            req.Slug,
+		Names:           req.Names,
+		Icon:            req.Icon,
+		DefaultTeamSize: req.DefaultTeamSize,
+		Aliases:         req.Aliases,
+	}
+
+	sport, err := h.sportService.UpdateSport(id, updates, req.IsActive)
+	if err != nil {
+		if err.Error() == "sport slug already exists" {
+			utils.RespondError(c, http.StatusConflict, "sport_exists", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "update_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, sport)
+}
+
+// DeleteSport godoc
+// @Summary Delete sport (admin only)
+// @Description Remove a sport that no event uses
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Sport ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /admin/sports/{id} [delete]
+func (h *SportHandler) DeleteSport(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid sport ID")
+		return
+	}
+
+	if _, err := h.sportService.GetSport(id); err != nil {
+		utils.RespondError(c, http.StatusNotFound, "sport_not_found", "Sport not found")
+		return
+	}
+
+	if err := h.sportService.DeleteSport(id); err != nil {
+		if err.Error() == "sport is used by existing events, deactivate it instead" {
+			utils.RespondError(c, http.StatusConflict, "sport_in_use", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusInternalServerError, "delete_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Sport deleted successfully"})
+}
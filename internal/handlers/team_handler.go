@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TeamHandler struct {
+	teamService *services.TeamService
+}
+
+func NewTeamHandler(teamService *services.TeamService) *TeamHandler {
+	return &TeamHandler{
+		teamService: teamService,
+	}
+}
+
+type GenerateTeamsRequest struct {
+	TeamCount int                   `json:"team_count" binding:"omitempty,min=2,max=8"`
+	Together  [][]uuid.UUID         `json:"together"`
+	Apart     [][]uuid.UUID         `json:"apart"`
+	Ratings   map[uuid.UUID]float64 `json:"ratings"`
+}
+
+// ListTeams godoc
+// @Summary List event teams
+// @Description Get the generated teams of an event with their colors and members
+// @Tags teams
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/teams [get]
+func (h *TeamHandler) ListTeams(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	teams, err := h.teamService.ListTeams(id, viewer, isAdmin)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "event_not_found", "Event not found")
+		return
+	}
+
+	utils.RespondSuccess(c, teamsResponse(teams))
+}
+
+// GenerateTeams godoc
+// @Summary Generate balanced teams
+// @Description Split the event's participants into balanced teams by skill level and organizer ratings, replacing any earlier split (owner, co-host or admin only)
+// @Tags teams
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body GenerateTeamsRequest true "Team options"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/teams [post]
+func (h *TeamHandler) GenerateTeams(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var req GenerateTeamsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	teams, err := h.teamService.GenerateTeams(id, userID, isAdmin, services.GenerateTeamsInput{
+		TeamCount: req.TeamCount,
+		Together:  req.Together,
+		Apart:     req.Apart,
+		Ratings:   req.Ratings,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "team_generation_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, teamsResponse(teams))
+}
+
+// DeleteTeams godoc
+// @Summary Clear event teams
+// @Description Remove the generated teams of an event (owner, co-host or admin only)
+// @Tags teams
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/teams [delete]
+func (h *TeamHandler) DeleteTeams(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	if err := h.teamService.DeleteTeams(id, userID, isAdmin); err != nil {
+		if errors.Is(err, services.ErrEventForbidden) {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "delete_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Teams cleared successfully"})
+}
+
+// teamsResponse exposes member names without the rest of the user record
+func teamsResponse(teams []models.EventTeam) []gin.H {
+	result := make([]gin.H, 0, len(teams))
+	for _, team := range teams {
+		members := make([]gin.H, 0, len(team.Members))
+		for _, m := range team.Members {
+			member := gin.H{"user_id": m.UserID}
+			if m.User != nil {
+				member["name"] = m.User.Name
+			}
+			members = append(members, member)
+		}
+		result = append(result, gin.H{
+			"id":       team.ID,
+			"name":     team.Name,
+			"color":    team.Color,
+			"position": team.Position,
+			"members":  members,
+		})
+	}
+	return result
+}
@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TemplateHandler struct {
+	templateService *services.TemplateService
+}
+
+func NewTemplateHandler(templateService *services.TemplateService) *TemplateHandler {
+	return &TemplateHandler{templateService: templateService}
+}
+
+type EventTemplateRequest struct {
+	Name         string     `json:"name" binding:"required,max=80"`
+	Title        string     `json:"title" binding:"required,max=120"`
+	SportType    string     `json:"sport_type" binding:"required,max=50"`
+	VenueID      *uuid.UUID `json:"venue_id"`
+	LocationName *string    `json:"location_name" binding:"omitempty,max=160"`
+	Address      *string    `json:"address"`
+	Latitude     *float64   `json:"latitude" binding:"required_without=VenueID,omitempty,min=-90,max=90"`
+	Longitude    *float64   `json:"longitude" binding:"required_without=VenueID,omitempty,min=-180,max=180"`
+	Capacity     int        `json:"capacity" binding:"required,min=1"`
+	Description  *string    `json:"description"`
+	PriceCents   int        `json:"price_cents" binding:"omitempty,min=0"`
+	Currency     string     `json:"currency" binding:"omitempty,len=3"`
+	Visibility   string     `json:"visibility" binding:"omitempty,oneof=public participants"`
+	DurationMin  int        `json:"duration_minutes" binding:"omitempty,min=15,max=1440"`
+	TimeZone     string     `json:"time_zone" binding:"omitempty,max=64"`
+}
+
+func (req *EventTemplateRequest) template(userID uuid.UUID) *models.EventTemplate {
+	template := &models.EventTemplate{
+		UserID:       userID,
+		Name:         req.Name,
+		Title:        req.Title,
+		SportType:    req.SportType,
+		VenueID:      req.VenueID,
+		LocationName: req.LocationName,
+		Address:      req.Address,
+		Capacity:     req.Capacity,
+		Description:  req.Description,
+		PriceCents:   req.PriceCents,
+		Currency:     req.Currency,
+		Visibility:   req.Visibility,
+		DurationMin:  req.DurationMin,
+		TimeZone:     req.TimeZone,
+	}
+	if req.Latitude != nil {
+		template.Latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		template.Longitude = *req.Longitude
+	}
+	return template
+}
+
+// ListTemplates godoc
+// @Summary List my event templates
+// @Description Get your saved event templates
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/event-templates [get]
+func (h *TemplateHandler) ListTemplates(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	templates, err := h.templateService.ListTemplates(userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch templates")
+		return
+	}
+
+	utils.RespondSuccess(c, templates)
+}
+
+// GetTemplate godoc
+// @Summary Get an event template
+// @Description Get one of your saved event templates
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Template ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /me/event-templates/{id} [get]
+func (h *TemplateHandler) GetTemplate(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid template ID")
+		return
+	}
+
+	template, err := h.templateService.GetTemplate(id, userID)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "template_not_found", "Template not found")
+		return
+	}
+
+	utils.RespondSuccess(c, template)
+}
+
+// CreateTemplate godoc
+// @Summary Save an event template
+// @Description Save event details (sport, venue or coordinates, capacity, description, price, visibility, duration) to reuse for future events
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body EventTemplateRequest true "Template"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/event-templates [post]
+func (h *TemplateHandler) CreateTemplate(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req EventTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	template := req.template(userID)
+	if err := h.templateService.CreateTemplate(template); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "create_failed", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{
+		Data: template,
+	})
+}
+
+// UpdateTemplate godoc
+// @Summary Update an event template
+// @Description Replace the details of one of your event templates
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Template ID"
+// @Param request body EventTemplateRequest true "Template"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /me/event-templates/{id} [put]
+func (h *TemplateHandler) UpdateTemplate(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid template ID")
+		return
+	}
+
+	var req EventTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	template, err := h.templateService.UpdateTemplate(id, userID, req.template(userID))
+	if err != nil {
+		if errors.Is(err, services.ErrTemplateNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "template_not_found", "Template not found")
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "update_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, template)
+}
+
+// DeleteTemplate godoc
+// @Summary Delete an event template
+// @Description Delete one of your event templates; events created from it are not affected
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Template ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /me/event-templates/{id} [delete]
+func (h *TemplateHandler) DeleteTemplate(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid template ID")
+		return
+	}
+
+	if err := h.templateService.DeleteTemplate(id, userID); err != nil {
+		if errors.Is(err, services.ErrTemplateNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "template_not_found", "Template not found")
+			return
+		}
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to delete template")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Template deleted"})
+}
+
+// CreateEventFromTemplate godoc
+// @Summary Create an event from a template
+// @Description Create an event with the template's details at the given time, validated like any new event
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Template ID"
+// @Param request body CopyEventRequest true "New occurrence"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /me/event-templates/{id}/events [post]
+func (h *TemplateHandler) CreateEventFromTemplate(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid template ID")
+		return
+	}
+
+	opts, ok := bindCopyEventRequest(c)
+	if !ok {
+		return
+	}
+
+	event, err := h.templateService.CreateEvent(id, userID, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrTemplateNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "template_not_found", "Template not found")
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "create_failed", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{
+		Data: event,
+	})
+}
@@ -0,0 +1,501 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TournamentHandler struct {
+	tournamentService *services.TournamentService
+}
+
+func NewTournamentHandler(tournamentService *services.TournamentService) *TournamentHandler {
+	return &TournamentHandler{
+		tournamentService: tournamentService,
+	}
+}
+
+type CreateTournamentRequest struct {
+	Format     string `json:"format" binding:"required,oneof=single_elimination round_robin"`
+	MaxTeams   int    `json:"max_teams" binding:"required,min=2,max=64"`
+	PointsWin  *int   `json:"points_win" binding:"omitempty,min=0"`
+	PointsDraw *int   `json:"points_draw" binding:"omitempty,min=0"`
+	PointsLoss *int   `json:"points_loss" binding:"omitempty,min=0"`
+}
+
+type RegisterTeamRequest struct {
+	Name      string      `json:"name" binding:"required,min=1,max=60"`
+	MemberIDs []uuid.UUID `json:"member_ids"`
+}
+
+type GenerateBracketRequest struct {
+	StartAt      *time.Time `json:"start_at"`
+	MatchMinutes int        `json:"match_minutes" binding:"omitempty,min=5,max=240"`
+	Courts       int        `json:"courts" binding:"omitempty,min=1,max=20"`
+}
+
+type ScheduleMatchRequest struct {
+	ScheduledAt time.Time `json:"scheduled_at" binding:"required"`
+}
+
+type RecordResultRequest struct {
+	HomeScore    *int       `json:"home_score" binding:"required,min=0"`
+	AwayScore    *int       `json:"away_score" binding:"required,min=0"`
+	WinnerTeamID *uuid.UUID `json:"winner_team_id"`
+}
+
+// CreateTournament godoc
+// @Summary Create a tournament
+// @Description Turn an event into a single-elimination or round-robin tournament (owner, co-host or admin only)
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body CreateTournamentRequest true "Tournament settings"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Router /events/{id}/tournament [post]
+func (h *TournamentHandler) CreateTournament(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var req CreateTournamentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	tournament, err := h.tournamentService.CreateTournament(id, userID, isAdmin, services.CreateTournamentInput{
+		Format:     req.Format,
+		MaxTeams:   req.MaxTeams,
+		PointsWin:  req.PointsWin,
+		PointsDraw: req.PointsDraw,
+		PointsLoss: req.PointsLoss,
+	})
+	if err != nil {
+		respondTournamentError(c, err, "tournament_creation_failed")
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{Data: tournament})
+}
+
+// GetEventTournament godoc
+// @Summary Get an event's tournament
+// @Description Get the tournament attached to an event
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /events/{id}/tournament [get]
+func (h *TournamentHandler) GetEventTournament(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid event ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	tournament, err := h.tournamentService.GetEventTournament(id, viewer, isAdmin)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "tournament_not_found", "Tournament not found")
+		return
+	}
+
+	utils.RespondSuccess(c, tournament)
+}
+
+// ListTeams godoc
+// @Summary List tournament teams
+// @Description Get the registered teams in seed order with their members
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /tournaments/{id}/teams [get]
+func (h *TournamentHandler) ListTeams(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid tournament ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	teams, err := h.tournamentService.ListTeams(id, viewer, isAdmin)
+	if err != nil {
+		respondTournamentError(c, err, "list_teams_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, teams)
+}
+
+// RegisterTeam godoc
+// @Summary Register a team
+// @Description Register a team captained by the current user; all members must have joined the event
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tournament ID"
+// @Param request body RegisterTeamRequest true "Team details"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /tournaments/{id}/teams [post]
+func (h *TournamentHandler) RegisterTeam(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid tournament ID")
+		return
+	}
+
+	var req RegisterTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	team, err := h.tournamentService.RegisterTeam(id, userID, req.Name, req.MemberIDs)
+	if err != nil {
+		respondTournamentError(c, err, "team_registration_failed")
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{Data: team})
+}
+
+// WithdrawTeam godoc
+// @Summary Withdraw a team
+// @Description Withdraw a team before the bracket is drawn (captain, owner, co-host or admin)
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tournament ID"
+// @Param teamId path string true "Team ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /tournaments/{id}/teams/{teamId} [delete]
+func (h *TournamentHandler) WithdrawTeam(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid tournament ID")
+		return
+	}
+
+	teamID, err := uuid.Parse(c.Param("teamId"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid team ID")
+		return
+	}
+
+	if err := h.tournamentService.WithdrawTeam(id, teamID, userID, isAdmin); err != nil {
+		respondTournamentError(c, err, "withdraw_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "Team withdrawn successfully"})
+}
+
+// GenerateBracket godoc
+// @Summary Generate the bracket
+// @Description Close registration, draw the fixtures and schedule them (owner, co-host or admin only)
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tournament ID"
+// @Param request body GenerateBracketRequest false "Scheduling options"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /tournaments/{id}/bracket [post]
+func (h *TournamentHandler) GenerateBracket(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid tournament ID")
+		return
+	}
+
+	var req GenerateBracketRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
+	}
+
+	matches, err := h.tournamentService.GenerateBracket(id, userID, isAdmin, services.GenerateBracketInput{
+		StartAt:      req.StartAt,
+		MatchMinutes: req.MatchMinutes,
+		Courts:       req.Courts,
+	})
+	if err != nil {
+		respondTournamentError(c, err, "bracket_generation_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, matches)
+}
+
+// ListMatches godoc
+// @Summary List tournament matches
+// @Description Get all matches by round with scores, schedule and bracket links
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /tournaments/{id}/matches [get]
+func (h *TournamentHandler) ListMatches(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid tournament ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	matches, err := h.tournamentService.ListMatches(id, viewer, isAdmin)
+	if err != nil {
+		respondTournamentError(c, err, "list_matches_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, matches)
+}
+
+// ScheduleMatch godoc
+// @Summary Reschedule a match
+// @Description Change the kick-off time of a scheduled match (owner, co-host or admin only)
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tournament ID"
+// @Param matchId path string true "Match ID"
+// @Param request body ScheduleMatchRequest true "New time"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /tournaments/{id}/matches/{matchId}/schedule [put]
+func (h *TournamentHandler) ScheduleMatch(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, matchID, ok := parseMatchParams(c)
+	if !ok {
+		return
+	}
+
+	var req ScheduleMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	match, err := h.tournamentService.ScheduleMatch(id, matchID, userID, isAdmin, req.ScheduledAt.UTC())
+	if err != nil {
+		respondTournamentError(c, err, "schedule_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, match)
+}
+
+// RecordResult godoc
+// @Summary Record a match result
+// @Description Enter the final score; knockout winners advance automatically and drawn knockout matches need winner_team_id (owner, co-host or admin only)
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tournament ID"
+// @Param matchId path string true "Match ID"
+// @Param request body RecordResultRequest true "Match result"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /tournaments/{id}/matches/{matchId}/result [put]
+func (h *TournamentHandler) RecordResult(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, matchID, ok := parseMatchParams(c)
+	if !ok {
+		return
+	}
+
+	var req RecordResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	match, err := h.tournamentService.RecordResult(id, matchID, userID, isAdmin, services.RecordResultInput{
+		HomeScore:    *req.HomeScore,
+		AwayScore:    *req.AwayScore,
+		WinnerTeamID: req.WinnerTeamID,
+	})
+	if err != nil {
+		respondTournamentError(c, err, "result_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, match)
+}
+
+// GetStandings godoc
+// @Summary Get tournament standings
+// @Description Get the table ranked by points, score difference and goals scored
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /tournaments/{id}/standings [get]
+func (h *TournamentHandler) GetStandings(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid tournament ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	standings, err := h.tournamentService.Standings(id, viewer, isAdmin)
+	if err != nil {
+		respondTournamentError(c, err, "standings_failed")
+		return
+	}
+
+	utils.RespondSuccess(c, standings)
+}
+
+func parseMatchParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid tournament ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	matchID, err := uuid.Parse(c.Param("matchId"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid match ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return id, matchID, true
+}
+
+func respondTournamentError(c *gin.Context, err error, code string) {
+	switch {
+	case errors.Is(err, services.ErrEventForbidden):
+		utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+	case errors.Is(err, services.ErrTournamentNotFound):
+		utils.RespondError(c, http.StatusNotFound, "tournament_not_found", "Tournament not found")
+	default:
+		utils.RespondError(c, http.StatusBadRequest, code, err.Error())
+	}
+}
@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type UserHandler struct {
+	profileService *services.ProfileService
+	followService  *services.FollowService
+	blockService   *services.BlockService
+}
+
+func NewUserHandler(profileService *services.ProfileService, followService *services.FollowService, blockService *services.BlockService) *UserHandler {
+	return &UserHandler{
+		profileService: profileService,
+		followService:  followService,
+		blockService:   blockService,
+	}
+}
+
+// GetUser godoc
+// @Summary Get a user's public profile
+// @Description Get another player's profile: avatar, bio, favorite sports, skill per sport, home area, events attended and hosted, and follower counts. Emails are never shown. The user's privacy settings decide what is included; profiles hidden from the caller only show the name, avatar and follower counts with restricted set. Signed-in callers also see whether they follow the user.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /users/{id} [get]
+func (h *UserHandler) GetUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	var viewer *uuid.UUID
+	if userID, ok := middlewares.GetUserID(c); ok {
+		viewer = &userID
+	}
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	profile, err := h.profileService.GetProfile(id, viewer, isAdmin)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "user_not_found", "User not found")
+			return
+		}
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch profile")
+		return
+	}
+
+	utils.RespondSuccess(c, profile)
+}
+
+// FollowUser godoc
+// @Summary Follow a user
+// @Description Follow another player to see the events they create or join in the following feed
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /users/{id}/follow [post]
+func (h *UserHandler) FollowUser(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := h.followService.Follow(userID, id); err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "user_not_found", "User not found")
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "follow_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "User followed"})
+}
+
+// UnfollowUser godoc
+// @Summary Unfollow a user
+// @Description Stop following another player
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /users/{id}/follow [delete]
+func (h *UserHandler) UnfollowUser(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := h.followService.Unfollow(userID, id); err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to unfollow user")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "User unfollowed"})
+}
+
+// ListFollowers godoc
+// @Summary List a user's followers
+// @Description Get the players following a user, most recent first
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /users/{id}/followers [get]
+func (h *UserHandler) ListFollowers(c *gin.Context) {
+	h.listFollows(c, h.followService.ListFollowers)
+}
+
+// ListFollowing godoc
+// @Summary List who a user follows
+// @Description Get the players a user follows, most recent first
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /users/{id}/following [get]
+func (h *UserHandler) ListFollowing(c *gin.Context) {
+	h.listFollows(c, h.followService.ListFollowing)
+}
+
+// BlockUser godoc
+// @Summary Block a user
+// @Description Block a user: you no longer see each other's events in the feed, they cannot join events you organize, and follows between you are removed
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /users/{id}/block [post]
+func (h *UserHandler) BlockUser(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := h.blockService.Block(userID, id); err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "user_not_found", "User not found")
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "block_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "User blocked"})
+}
+
+// UnblockUser godoc
+// @Summary Unblock a user
+// @Description Lift a block; earlier follows are not restored
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /users/{id}/block [delete]
+func (h *UserHandler) UnblockUser(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	if err := h.blockService.Unblock(userID, id); err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to unblock user")
+		return
+	}
+
+	utils.RespondSuccess(c, gin.H{"message": "User unblocked"})
+}
+
+// ListMyBlocks godoc
+// @Summary List blocked users
+// @Description Get the users you blocked, most recent first
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /me/blocks [get]
+func (h *UserHandler) ListMyBlocks(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var query struct {
+		Page  int `form:"page"`
+		Limit int `form:"limit"`
+	}
+	_ = c.ShouldBindQuery(&query)
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	users, total, err := h.blockService.ListBlocked(userID, pagination.GetOffset(), pagination.Limit)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch blocked users")
+		return
+	}
+
+	entries := make([]gin.H, 0, len(users))
+	for i := range users {
+		entries = append(entries, publicUserResponse(&users[i]))
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, entries, &meta)
+}
+
+func (h *UserHandler) listFollows(c *gin.Context, list func(uuid.UUID, int, int) ([]models.User, int64, error)) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid user ID")
+		return
+	}
+
+	var query struct {
+		Page  int `form:"page"`
+		Limit int `form:"limit"`
+	}
+	_ = c.ShouldBindQuery(&query)
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	users, total, err := list(id, pagination.GetOffset(), pagination.Limit)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			utils.RespondError(c, http.StatusNotFound, "user_not_found", "User not found")
+			return
+		}
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch users")
+		return
+	}
+
+	entries := make([]gin.H, 0, len(users))
+	for i := range users {
+		entries = append(entries, publicUserResponse(&users[i]))
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, entries, &meta)
+}
+
+// publicUserResponse is what other players may see of a user in lists; never the email
+func publicUserResponse(user *models.User) gin.H {
+	return gin.H{
+		"id":         user.ID,
+		"name":       user.Name,
+		"avatar_url": user.AvatarURL,
+	}
+}
@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"playspotter/internal/middlewares"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"playspotter/internal/services"
+	"playspotter/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type VenueHandler struct {
+	venueService *services.VenueService
+}
+
+func NewVenueHandler(venueService *services.VenueService) *VenueHandler {
+	return &VenueHandler{
+		venueService: venueService,
+	}
+}
+
+type CreateVenueRequest struct {
+	Name         string            `json:"name" binding:"required,max=160"`
+	Address      *string           `json:"address"`
+	Latitude     *float64          `json:"latitude" binding:"required,min=-90,max=90"`
+	Longitude    *float64          `json:"longitude" binding:"required,min=-180,max=180"`
+	Sports       []string          `json:"sports"`
+	Amenities    []string          `json:"amenities"`
+	OpeningHours map[string]string `json:"opening_hours"`
+}
+
+type UpdateVenueRequest struct {
+	Name         string            `json:"name" binding:"omitempty,max=160"`
+	Address      *string           `json:"address"`
+	Latitude     *float64          `json:"latitude" binding:"omitempty,min=-90,max=90"`
+	Longitude    *float64          `json:"longitude" binding:"omitempty,min=-180,max=180"`
+	Sports       []string          `json:"sports"`
+	Amenities    []string          `json:"amenities"`
+	OpeningHours map[string]string `json:"opening_hours"`
+}
+
+type MergeVenueRequest struct {
+	DuplicateID uuid.UUID `json:"duplicate_id" binding:"required"`
+}
+
+type VenueSearchQuery struct {
+	Lat         *float64 `form:"lat" binding:"omitempty,min=-90,max=90"`
+	Lng         *float64 `form:"lng" binding:"omitempty,min=-180,max=180"`
+	MaxDistance *float64 `form:"max_distance_km" binding:"omitempty,min=0"`
+	SportType   string   `form:"sport_type"`
+	Query       string   `form:"q"`
+	Page        int      `form:"page" binding:"omitempty,min=1"`
+	Limit       int      `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ListVenues godoc
+// @Summary Search venues
+// @Description Search venues by name, sport and proximity (nearest first when lat/lng are given)
+// @Tags venues
+// @Accept json
+// @Produce json
+// @Param lat query number false "Latitude"
+// @Param lng query number false "Longitude"
+// @Param max_distance_km query number false "Maximum distance in km"
+// @Param sport_type query string false "Sport type"
+// @Param q query string false "Name or address contains"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /venues [get]
+func (h *VenueHandler) ListVenues(c *gin.Context) {
+	var query VenueSearchQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	pagination := utils.NewPaginationParams(query.Page, query.Limit)
+
+	filter := repositories.VenueFilter{
+		Lat:         query.Lat,
+		Lng:         query.Lng,
+		MaxDistance: query.MaxDistance,
+		SportType:   query.SportType,
+		Query:       query.Query,
+		Offset:      pagination.GetOffset(),
+		Limit:       pagination.Limit,
+	}
+
+	venues, total, err := h.venueService.SearchVenues(filter)
+	if err != nil {
+		utils.RespondError(c, http.StatusInternalServerError, "internal_error", "Failed to fetch venues")
+		return
+	}
+
+	meta := pagination.GetMeta(total)
+	utils.RespondSuccessWithMeta(c, venues, &meta)
+}
+
+// GetVenue godoc
+// @Summary Get venue by ID
+// @Description Get detailed information about a venue
+// @Tags venues
+// @Accept json
+// @Produce json
+// @Param id path string true "Venue ID"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /venues/{id} [get]
+func (h *VenueHandler) GetVenue(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid venue ID")
+		return
+	}
+
+	venue, err := h.venueService.GetVenue(id)
+	if err != nil {
+		utils.RespondError(c, http.StatusNotFound, "venue_not_found", "Venue not found")
+		return
+	}
+
+	utils.RespondSuccess(c, venue)
+}
+
+// CreateVenue godoc
+// @Summary Create a venue
+// @Description Register a venue that events can reference
+// @Tags venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateVenueRequest true "Venue details"
+// @Success 201 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Router /venues [post]
+func (h *VenueHandler) CreateVenue(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	var req CreateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	venue := &models.Venue{
+		Name:         req.Name,
+		Address:      req.Address,
+		Latitude:     *req.Latitude,
+		Longitude:    *req.Longitude,
+		Sports:       req.Sports,
+		Amenities:    req.Amenities,
+		OpeningHours: req.OpeningHours,
+		CreatedBy:    &userID,
+	}
+
+	if err := h.venueService.CreateVenue(venue); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "create_failed", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, utils.SuccessResponse{
+		Data: venue,
+	})
+}
+
+// UpdateVenue godoc
+// @Summary Update a venue
+// @Description Update venue details (creator or admin only); upcoming events at the venue follow the new location. Only admins can move a venue that other organizers' upcoming events use.
+// @Tags venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Venue ID"
+// @Param request body UpdateVenueRequest true "Update details"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /venues/{id} [put]
+func (h *VenueHandler) UpdateVenue(c *gin.Context) {
+	userID, ok := middlewares.GetUserID(c)
+	if !ok {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "User ID not found")
+		return
+	}
+
+	role, _ := middlewares.GetUserRole(c)
+	isAdmin := role == "admin"
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid venue ID")
+		return
+	}
+
+	var req UpdateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	if _, err := h.venueService.GetVenue(id); err != nil {
+		utils.RespondError(c, http.StatusNotFound, "venue_not_found", "Venue not found")
+		return
+	}
+
+	updates := &models.Venue{
+		Name:         req.Name,
+		Address:      req.Address,
+		Sports:       req.Sports,
+		Amenities:    req.Amenities,
+		OpeningHours: req.OpeningHours,
+	}
+	if req.Latitude != nil {
+		updates.Latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		updates.Longitude = *req.Longitude
+	}
+
+	venue, err := h.venueService.UpdateVenue(id, updates, userID, isAdmin)
+	if err != nil {
+		if err.Error() == "only venue creator or admin can update this venue" {
+			utils.RespondError(c, http.StatusForbidden, "forbidden", err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrVenueInUse) {
+			utils.RespondError(c, http.StatusConflict, "venue_in_use", err.Error())
+			return
+		}
+		utils.RespondError(c, http.StatusBadRequest, "update_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, venue)
+}
+
+// MergeVenues godoc
+// @Summary Merge duplicate venues (admin only)
+// @Description Move all events of the duplicate venue onto this venue and delete the duplicate
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Target venue ID"
+// @Param request body MergeVenueRequest true "Duplicate venue"
+// @Success 200 {object} utils.SuccessResponse
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 401 {object} utils.ErrorResponse
+// @Failure 403 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /admin/venues/{id}/merge [post]
+func (h *VenueHandler) MergeVenues(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "invalid_id", "Invalid venue ID")
+		return
+	}
+
+	var req MergeVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+
+	if _, err := h.venueService.GetVenue(id); err != nil {
+		utils.RespondError(c, http.StatusNotFound, "venue_not_found", "Venue not found")
+		return
+	}
+	if _, err := h.venueService.GetVenue(req.DuplicateID); err != nil {
+		utils.RespondError(c, http.StatusNotFound, "venue_not_found", "Duplicate venue not found")
+		return
+	}
+
+	venue, err := h.venueService.MergeVenues(id, req.DuplicateID)
+	if err != nil {
+		utils.RespondError(c, http.StatusBadRequest, "merge_failed", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, venue)
+}
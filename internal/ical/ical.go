@@ -0,0 +1,135 @@
+// Package ical writes iCalendar (RFC 5545) documents.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ProductID identifies the application that produced the calendar
+const ProductID = "-//PlaySpotter//Events//EN"
+
+// Event statuses
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// maxLineOctets is the longest a content line may be before it is folded
+const maxLineOctets = 75
+
+// Calendar is a VCALENDAR with its events
+type Calendar struct {
+	// Name is shown by calendar apps for subscribed calendars
+	Name   string
+	Events []Event
+}
+
+// Event is a VEVENT. Times are written in UTC.
+type Event struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	URL          string
+	Start        time.Time
+	End          time.Time
+	Status       string
+	Created      time.Time
+	LastModified time.Time
+	// Latitude and Longitude are written as GEO when HasGeo is set
+	HasGeo    bool
+	Latitude  float64
+	Longitude float64
+}
+
+// Write encodes the calendar with CRLF line endings and folded lines
+func Write(w io.Writer, cal Calendar) error {
+	bw := bufio.NewWriter(w)
+	stamp := time.Now()
+
+	writeLine(bw, "BEGIN:VCALENDAR")
+	writeLine(bw, "VERSION:2.0")
+	writeLine(bw, "PRODID:"+ProductID)
+	writeLine(bw, "CALSCALE:GREGORIAN")
+	writeLine(bw, "METHOD:PUBLISH")
+	if cal.Name != "" {
+		writeLine(bw, "X-WR-CALNAME:"+escapeText(cal.Name))
+	}
+
+	for _, e := range cal.Events {
+		writeLine(bw, "BEGIN:VEVENT")
+		writeLine(bw, "UID:"+e.UID)
+		writeLine(bw, "DTSTAMP:"+formatTime(stamp))
+		writeLine(bw, "DTSTART:"+formatTime(e.Start))
+		writeLine(bw, "DTEND:"+formatTime(e.End))
+		writeLine(bw, "SUMMARY:"+escapeText(e.Summary))
+		if e.Description != "" {
+			writeLine(bw, "DESCRIPTION:"+escapeText(e.Description))
+		}
+		if e.Location != "" {
+			writeLine(bw, "LOCATION:"+escapeText(e.Location))
+		}
+		if e.HasGeo {
+			writeLine(bw, fmt.Sprintf("GEO:%.6f;%.6f", e.Latitude, e.Longitude))
+		}
+		if e.URL != "" {
+			writeLine(bw, "URL:"+e.URL)
+		}
+		if e.Status != "" {
+			writeLine(bw, "STATUS:"+e.Status)
+		}
+		if !e.Created.IsZero() {
+			writeLine(bw, "CREATED:"+formatTime(e.Created))
+		}
+		if !e.LastModified.IsZero() {
+			writeLine(bw, "LAST-MODIFIED:"+formatTime(e.LastModified))
+		}
+		writeLine(bw, "END:VEVENT")
+	}
+
+	writeLine(bw, "END:VCALENDAR")
+	return bw.Flush()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeText escapes a TEXT value (RFC 5545 section 3.3.11)
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// writeLine folds the line after 75 octets without splitting UTF-8 sequences
+// (RFC 5545 section 3.1)
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts toward the limit
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
@@ -0,0 +1,84 @@
+package ical_test
+
+import (
+	"bytes"
+	"playspotter/internal/ical"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteEvent(t *testing.T) {
+	start := time.Date(2026, 3, 10, 19, 0, 0, 0, time.FixedZone("WITA", 8*3600))
+	var buf bytes.Buffer
+	err := ical.Write(&buf, ical.Calendar{
+		Name: "My games",
+		Events: []ical.Event{{
+			UID:         "abc@playspotter",
+			Summary:     "Futsal; 5v5, casual",
+			Description: "Bring shoes\nand water",
+			Start:       start,
+			End:         start.Add(90 * time.Minute),
+			Status:      ical.StatusCancelled,
+			HasGeo:      true,
+			Latitude:    -8.65,
+			Longitude:   115.2167,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"VERSION:2.0\r\n",
+		"X-WR-CALNAME:My games\r\n",
+		"UID:abc@playspotter\r\n",
+		"DTSTART:20260310T110000Z\r\n",
+		"DTEND:20260310T123000Z\r\n",
+		`SUMMARY:Futsal\; 5v5\, casual` + "\r\n",
+		`DESCRIPTION:Bring shoes\nand water` + "\r\n",
+		"GEO:-8.650000;115.216700\r\n",
+		"STATUS:CANCELLED\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(strings.ReplaceAll(out, "\r\n", ""), "\n") {
+		t.Error("output contains a bare LF")
+	}
+}
+
+func TestWriteFoldsLongLines(t *testing.T) {
+	var buf bytes.Buffer
+	err := ical.Write(&buf, ical.Calendar{Events: []ical.Event{{
+		UID:     "long",
+		Summary: strings.Repeat("é", 100),
+	}}})
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	var summary strings.Builder
+	inSummary := false
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line is %d octets long", len(line))
+		}
+		switch {
+		case strings.HasPrefix(line, "SUMMARY:"):
+			inSummary = true
+			summary.WriteString(line)
+		case inSummary && strings.HasPrefix(line, " "):
+			summary.WriteString(line[1:])
+		default:
+			inSummary = false
+		}
+	}
+	if summary.String() != "SUMMARY:"+strings.Repeat("é", 100) {
+		t.Errorf("unfolded summary = %q", summary.String())
+	}
+}
@@ -0,0 +1,250 @@
+// Package jobs runs background work queued in Postgres. Every replica may run a
+// Runner; leases make sure a due job is handled by one of them at a time.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Handler performs one job. Handlers must be idempotent: a job whose worker dies
+// or outlives its lease is run again.
+type Handler func(ctx context.Context, job *models.Job) error
+
+// ErrPermanent can be wrapped by handlers to fail a job without retrying it
+var ErrPermanent = errors.New("permanent job failure")
+
+// JobPurge deletes completed jobs older than the runner's retention
+const JobPurge = "jobs_purge"
+
+const (
+	defaultBatchSize = 10
+	// purgeInterval is how often completed jobs past the retention are deleted
+	purgeInterval = time.Hour
+	// retryBaseDelay is the wait before the first retry; it doubles with every attempt
+	retryBaseDelay = 30 * time.Second
+	maxRetryDelay  = time.Hour
+)
+
+type Runner struct {
+	repo         *repositories.JobRepository
+	handlers     map[string]Handler
+	periodic     []*periodicJob
+	workerID     string
+	pollInterval time.Duration
+	lease        time.Duration
+	retention    time.Duration
+
+	cancel context.CancelFunc
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// NewRunner creates a runner; completed jobs are deleted once they are older than retention
+func NewRunner(repo *repositories.JobRepository, pollInterval, lease, retention time.Duration) *Runner {
+	host, _ := os.Hostname()
+	r := &Runner{
+		repo:         repo,
+		handlers:     make(map[string]Handler),
+		workerID:     fmt.Sprintf("%s-%d-%s", host, os.Getpid(), uuid.NewString()[:8]),
+		pollInterval: pollInterval,
+		lease:        lease,
+		retention:    retention,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	r.Every(JobPurge, purgeInterval, r.purge)
+	return r
+}
+
+// Handle registers the handler for a job type; call it before Start
+func (r *Runner) Handle(jobType string, handler Handler) {
+	r.handlers[jobType] = handler
+}
+
+// periodicJob is queued once per interval; lastSlot avoids re-queueing a slot this
+// process already queued
+type periodicJob struct {
+	jobType  string
+	interval time.Duration
+	lastSlot time.Time
+}
+
+// Every registers a handler that runs once per interval across all replicas. Each
+// interval gets its own job with a unique key, so however many runners queue it,
+// it only runs once.
+func (r *Runner) Every(jobType string, interval time.Duration, handler Handler) {
+	r.Handle(jobType, handler)
+	r.periodic = append(r.periodic, &periodicJob{jobType: jobType, interval: interval})
+}
+
+// Start polls for due jobs in the background until Shutdown is called
+func (r *Runner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.pollInterval)
+		defer ticker.Stop()
+
+		log.Printf("Job runner %s started", r.workerID)
+		for {
+			r.queuePeriodic()
+			r.runDue(ctx)
+
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Shutdown stops polling and waits for the running job to finish. If ctx ends
+// first the job's context is cancelled; its lease expires and another worker
+// retries it.
+func (r *Runner) Shutdown(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	close(r.stop)
+
+	select {
+	case <-r.done:
+		r.cancel()
+		return nil
+	case <-ctx.Done():
+		r.cancel()
+		<-r.done
+		return ctx.Err()
+	}
+}
+
+func (r *Runner) queuePeriodic() {
+	now := time.Now().UTC()
+	for _, p := range r.periodic {
+		slot := now.Truncate(p.interval)
+		if slot.Equal(p.lastSlot) {
+			continue
+		}
+
+		key := fmt.Sprintf("%s:%d", p.jobType, slot.Unix())
+		job := &models.Job{
+			Type:        p.jobType,
+			Payload:     map[string]interface{}{},
+			UniqueKey:   &key,
+			RunAt:       slot,
+			MaxAttempts: 1,
+		}
+		if err := r.repo.Enqueue(job); err != nil {
+			log.Printf("jobs: failed to queue %s: %v", p.jobType, err)
+			continue
+		}
+		p.lastSlot = slot
+	}
+}
+
+func (r *Runner) runDue(ctx context.Context) {
+	for {
+		select {
+		case <-r.stop:
+			return
+		default:
+		}
+
+		if failed, err := r.repo.FailExpired(); err != nil {
+			log.Printf("jobs: failed to fail expired jobs: %v", err)
+		} else if failed > 0 {
+			log.Printf("jobs: %d jobs failed after their last lease expired", failed)
+		}
+
+		jobs, err := r.repo.Lease(r.workerID, defaultBatchSize, r.lease)
+		if err != nil {
+			log.Printf("jobs: failed to lease jobs: %v", err)
+			return
+		}
+
+		for i := range jobs {
+			r.run(ctx, &jobs[i])
+		}
+		if len(jobs) < defaultBatchSize {
+			return
+		}
+	}
+}
+
+func (r *Runner) run(ctx context.Context, job *models.Job) {
+	handler, ok := r.handlers[job.Type]
+	if !ok {
+		r.fail(job, fmt.Errorf("%w: no handler for job type %q", ErrPermanent, job.Type))
+		return
+	}
+
+	if err := r.safeRun(ctx, handler, job); err != nil {
+		r.fail(job, err)
+		return
+	}
+
+	if err := r.repo.Complete(job.ID, r.workerID); err != nil {
+		log.Printf("jobs: failed to complete job %s: %v", job.ID, err)
+	}
+}
+
+// safeRun keeps a panicking handler from taking the runner down
+func (r *Runner) safeRun(ctx context.Context, handler Handler, job *models.Job) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panicked: %v", p)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+func (r *Runner) fail(job *models.Job, err error) {
+	var retryAt *time.Time
+	if !errors.Is(err, ErrPermanent) && job.Attempts < job.MaxAttempts {
+		at := time.Now().UTC().Add(RetryDelay(job.Attempts))
+		retryAt = &at
+	}
+
+	log.Printf("jobs: %s job %s failed (attempt %d/%d): %v", job.Type, job.ID, job.Attempts, job.MaxAttempts, err)
+	if err := r.repo.Fail(job.ID, r.workerID, err.Error(), retryAt); err != nil {
+		log.Printf("jobs: failed to record failure of job %s: %v", job.ID, err)
+	}
+}
+
+// purge deletes completed jobs past the retention, including the one job per
+// interval that Every queues
+func (r *Runner) purge(ctx context.Context, job *models.Job) error {
+	purged, err := r.repo.PurgeDone(time.Now().UTC().Add(-r.retention))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		log.Printf("jobs: purged %d completed jobs", purged)
+	}
+	return nil
+}
+
+// RetryDelay is the backoff before retrying a job that failed on the given attempt
+func RetryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
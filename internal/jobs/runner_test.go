@@ -0,0 +1,27 @@
+package jobs_test
+
+import (
+	"playspotter/internal/jobs"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 30 * time.Second},
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{8, time.Hour},
+		{50, time.Hour},
+	}
+
+	for _, tc := range cases {
+		if got := jobs.RetryDelay(tc.attempt); got != tc.want {
+			t.Errorf("RetryDelay(%d) = %v, want %v", tc.attempt, got, tc.want)
+		}
+	}
+}
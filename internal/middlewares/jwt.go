@@ -11,7 +11,12 @@ import (
 	"github.com/google/uuid"
 )
 
-func JWTAuth(jwtManager *jwt.Manager) gin.HandlerFunc {
+// AccountChecker returns why the user may no longer use the API, such as a suspended
+// or banned account, or nil. It runs after the token is validated so restrictions
+// apply to access tokens that were issued before them.
+type AccountChecker func(userID uuid.UUID) error
+
+func JWTAuth(jwtManager *jwt.Manager, checkAccount AccountChecker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -20,27 +25,81 @@ func JWTAuth(jwtManager *jwt.Manager) gin.HandlerFunc {
 			return
 		}
 
-		// Check if the header starts with "Bearer "
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "Invalid authorization header format")
-			c.Abort()
+		if authenticate(c, jwtManager, checkAccount, authHeader) {
+			c.Next()
+		}
+	}
+}
+
+// OptionalJWTAuth identifies the caller when a token is sent and lets anonymous
+// requests through; an invalid token is still rejected
+func OptionalJWTAuth(jwtManager *jwt.Manager, checkAccount AccountChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.Next()
 			return
 		}
 
-		tokenString := parts[1]
-		claims, err := jwtManager.ValidateAccessToken(tokenString)
-		if err != nil {
-			utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "Invalid or expired token")
+		if authenticate(c, jwtManager, checkAccount, authHeader) {
+			c.Next()
+		}
+	}
+}
+
+// StreamJWTAuth is JWTAuth for long-lived streams. Browsers cannot set headers on
+// an EventSource, so the token may also be sent as the access_token query parameter.
+func StreamJWTAuth(jwtManager *jwt.Manager, checkAccount AccountChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			if token := c.Query("access_token"); token != "" {
+				authHeader = "Bearer " + token
+			}
+		}
+		if authHeader == "" {
+			utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "Missing authorization header")
 			c.Abort()
 			return
 		}
 
-		// Set user info in context
-		c.Set("user_id", claims.UserID)
-		c.Set("user_role", claims.Role)
-		c.Next()
+		if authenticate(c, jwtManager, checkAccount, authHeader) {
+			c.Next()
+		}
+	}
+}
+
+// authenticate validates the bearer token and the user's account and stores the
+// user in the context, aborting the request when either is not acceptable
+func authenticate(c *gin.Context, jwtManager *jwt.Manager, checkAccount AccountChecker, authHeader string) bool {
+	// Check if the header starts with "Bearer "
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "Invalid authorization header format")
+		c.Abort()
+		return false
 	}
+
+	tokenString := parts[1]
+	claims, err := jwtManager.ValidateAccessToken(tokenString)
+	if err != nil {
+		utils.RespondError(c, http.StatusUnauthorized, "unauthorized", "Invalid or expired token")
+		c.Abort()
+		return false
+	}
+
+	if checkAccount != nil {
+		if err := checkAccount(claims.UserID); err != nil {
+			utils.RespondError(c, http.StatusForbidden, "account_restricted", err.Error())
+			c.Abort()
+			return false
+		}
+	}
+
+	// Set user info in context
+	c.Set("user_id", claims.UserID)
+	c.Set("user_role", claims.Role)
+	return true
 }
 
 // GetUserID retrieves the user ID from the context
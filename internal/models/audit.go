@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audited actions
+const (
+	AuditActionLogin             = "auth.login"
+	AuditActionLoginFailed       = "auth.login_failed"
+	AuditActionTokenRevoked      = "auth.token_revoked"
+	AuditActionAllTokensRevoked  = "auth.all_tokens_revoked"
+	AuditActionAdminBootstrapped = "admin.bootstrapped"
+	AuditActionRoleChanged       = "user.role_changed"
+	AuditActionEventStatusSet    = "event.status_changed"
+)
+
+// What an audit entry is about
+const (
+	AuditTargetUser         = "user"
+	AuditTargetEvent        = "event"
+	AuditTargetRefreshToken = "refresh_token"
+)
+
+// AuditLog is an entry in the append-only log of admin and security actions. The
+// database rejects updates and deletes. Before and After hold only the fields that
+// changed.
+type AuditLog struct {
+	ID uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	// ActorID is empty for anonymous requests such as failed logins and the admin bootstrap
+	ActorID    *uuid.UUID             `gorm:"type:uuid" json:"actor_id,omitempty"`
+	Action     string                 `gorm:"type:varchar(50);not null" json:"action"`
+	TargetType string                 `gorm:"type:varchar(20);not null" json:"target_type"`
+	TargetID   *uuid.UUID             `gorm:"type:uuid" json:"target_id,omitempty"`
+	Before     map[string]interface{} `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"before"`
+	After      map[string]interface{} `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"after"`
+	IP         string                 `gorm:"type:varchar(45);not null;default:''" json:"ip"`
+	RequestID  string                 `gorm:"type:varchar(64);not null;default:''" json:"request_id"`
+	CreatedAt  time.Time              `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
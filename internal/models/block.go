@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserBlock records that one user blocked another. Blocked users do not see the
+// blocker's events in their feed and cannot join them, and vice versa.
+type UserBlock struct {
+	BlockerID uuid.UUID `gorm:"type:uuid;primary_key" json:"blocker_id"`
+	BlockedID uuid.UUID `gorm:"type:uuid;primary_key;check:blocker_id <> blocked_id" json:"blocked_id"`
+	CreatedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+
+	// Relations (not stored in DB)
+	Blocked *User `gorm:"foreignKey:BlockedID" json:"blocked,omitempty"`
+}
+
+func (UserBlock) TableName() string {
+	return "user_blocks"
+}
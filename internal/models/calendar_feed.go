@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarFeed is a user's secret calendar subscription. Only a hash of the
+// token in the subscription URL is stored.
+type CalendarFeed struct {
+	UserID         uuid.UUID  `gorm:"type:uuid;primary_key" json:"-"`
+	TokenHash      string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	IncludeLiked   bool       `gorm:"not null;default:false" json:"include_liked"`
+	CreatedAt      time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	LastAccessedAt *time.Time `gorm:"type:timestamptz" json:"last_accessed_at,omitempty"`
+}
+
+func (CalendarFeed) TableName() string {
+	return "calendar_feeds"
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventCoHost struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID   uuid.UUID  `gorm:"type:uuid;not null" json:"event_id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	AddedBy   *uuid.UUID `gorm:"type:uuid" json:"added_by,omitempty"`
+	CreatedAt time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+
+	// Relations
+	User *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+func (EventCoHost) TableName() string {
+	return "event_cohosts"
+}
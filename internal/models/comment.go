@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventComment is a message in an event's discussion. Replies point at a top-level
+// comment through ParentID; deleted comments keep their row so replies stay threaded.
+type EventComment struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID      uuid.UUID  `gorm:"type:uuid;not null" json:"event_id"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	ParentID     *uuid.UUID `gorm:"type:uuid" json:"parent_id,omitempty"`
+	Body         string     `gorm:"type:text;not null" json:"body"`
+	Pinned       bool       `gorm:"not null;default:false" json:"pinned"`
+	PinnedAt     *time.Time `gorm:"type:timestamptz" json:"pinned_at,omitempty"`
+	PinnedBy     *uuid.UUID `gorm:"type:uuid" json:"pinned_by,omitempty"`
+	EditedAt     *time.Time `gorm:"type:timestamptz" json:"edited_at,omitempty"`
+	DeletedAt    *time.Time `gorm:"type:timestamptz" json:"deleted_at,omitempty"`
+	DeletedBy    *uuid.UUID `gorm:"type:uuid" json:"deleted_by,omitempty"`
+	HiddenAt     *time.Time `gorm:"type:timestamptz" json:"hidden_at,omitempty"`
+	HiddenBy     *uuid.UUID `gorm:"type:uuid" json:"hidden_by,omitempty"`
+	HiddenReason *string    `gorm:"type:text" json:"hidden_reason,omitempty"`
+	CreatedAt    time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+
+	// Relations
+	User    *User          `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Replies []EventComment `gorm:"foreignKey:ParentID" json:"replies,omitempty"`
+}
+
+func (EventComment) TableName() string {
+	return "event_comments"
+}
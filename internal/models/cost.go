@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventCostSplit is the shared cost of an event entered by the organizer after the game
+type EventCostSplit struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID    uuid.UUID `gorm:"type:uuid;unique;not null" json:"event_id"`
+	TotalCents int       `gorm:"type:int;not null;check:total_cents > 0" json:"total_cents"`
+	Currency   string    `gorm:"type:varchar(3);not null" json:"currency"`
+	Note       *string   `gorm:"type:text" json:"note,omitempty"`
+	CreatedBy  uuid.UUID `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt  time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+
+	// Relations
+	Shares []EventCostShare `gorm:"foreignKey:SplitID" json:"shares,omitempty"`
+}
+
+func (EventCostSplit) TableName() string {
+	return "event_cost_splits"
+}
+
+// EventCostShare is what one checked-in participant owes towards a cost split
+type EventCostShare struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	SplitID        uuid.UUID  `gorm:"type:uuid;not null" json:"split_id"`
+	EventID        uuid.UUID  `gorm:"type:uuid;not null" json:"event_id"`
+	UserID         uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	AmountCents    int        `gorm:"type:int;not null;check:amount_cents >= 0" json:"amount_cents"`
+	Exempt         bool       `gorm:"not null;default:false" json:"exempt"`
+	SettledAt      *time.Time `gorm:"type:timestamptz" json:"settled_at,omitempty"`
+	SettledBy      *uuid.UUID `gorm:"type:uuid" json:"settled_by,omitempty"`
+	LastRemindedAt *time.Time `gorm:"type:timestamptz" json:"last_reminded_at,omitempty"`
+	ReminderCount  int        `gorm:"type:int;not null;default:0" json:"reminder_count"`
+
+	// Relations
+	User  *User  `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Event *Event `gorm:"foreignKey:EventID" json:"event,omitempty"`
+}
+
+func (EventCostShare) TableName() string {
+	return "event_cost_shares"
+}
+
+// Outstanding reports whether the participant still has to pay
+func (s EventCostShare) Outstanding() bool {
+	return !s.Exempt && s.AmountCents > 0 && s.SettledAt == nil
+}
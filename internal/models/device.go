@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Device platforms accepted for push registration
+const (
+	DevicePlatformIOS     = "ios"
+	DevicePlatformAndroid = "android"
+	DevicePlatformWeb     = "web"
+)
+
+// DeviceToken is a push token registered by one of the user's devices. A token
+// belongs to the user who registered it last.
+type DeviceToken struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null" json:"-"`
+	Token      string    `gorm:"type:text;not null;uniqueIndex" json:"token"`
+	Platform   string    `gorm:"type:varchar(10);not null;check:platform IN ('ios','android','web')" json:"platform"`
+	CreatedAt  time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	LastSeenAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"last_seen_at"`
+}
+
+func (DeviceToken) TableName() string {
+	return "device_tokens"
+}
@@ -4,33 +4,93 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// Event visibility controls who can read the discussion
+const (
+	EventVisibilityPublic       = "public"
+	EventVisibilityParticipants = "participants"
+)
+
+// Event statuses. Drafts are not listed yet; open and full events take players;
+// ongoing and completed are set by the lifecycle job once the event starts and ends.
+const (
+	EventStatusDraft     = "draft"
+	EventStatusOpen      = "open"
+	EventStatusFull      = "full"
+	EventStatusOngoing   = "ongoing"
+	EventStatusCompleted = "completed"
+	EventStatusCancelled = "cancelled"
+)
+
+// DefaultEventDuration is used when the organizer does not say how long an event lasts
+const DefaultEventDuration = 120
+
 type Event struct {
-	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	CreatorID    uuid.UUID `gorm:"type:uuid;not null" json:"creator_id"`
-	Title        string    `gorm:"type:varchar(120);not null" json:"title"`
-	SportType    string    `gorm:"type:varchar(50);not null" json:"sport_type"`
-	EventTime    time.Time `gorm:"type:timestamptz;not null" json:"event_time"`
-	LocationName *string   `gorm:"type:varchar(160)" json:"location_name,omitempty"`
-	Address      *string   `gorm:"type:text" json:"address,omitempty"`
-	Latitude     float64   `gorm:"type:decimal(9,6);not null" json:"latitude"`
-	Longitude    float64   `gorm:"type:decimal(9,6);not null" json:"longitude"`
-	Capacity     int       `gorm:"type:int;not null;check:capacity >= 1" json:"capacity"`
-	Description  *string   `gorm:"type:text" json:"description,omitempty"`
-	Status       string    `gorm:"type:text;not null;default:'open';check:status IN ('open','full','cancelled')" json:"status"`
-	CreatedAt    time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
-	UpdatedAt    time.Time `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+	ID                 uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	CreatorID          uuid.UUID  `gorm:"type:uuid;not null" json:"creator_id"`
+	Title              string     `gorm:"type:varchar(120);not null" json:"title"`
+	SportType          string     `gorm:"type:varchar(50);not null" json:"sport_type"`
+	EventTime          time.Time  `gorm:"type:timestamptz;not null" json:"event_time"`
+	DurationMin        int        `gorm:"column:duration_minutes;type:int;not null;default:120;check:duration_minutes BETWEEN 15 AND 1440" json:"duration_minutes"`
+	TimeZone           string     `gorm:"type:varchar(64);not null;default:'UTC'" json:"time_zone"`
+	VenueID            *uuid.UUID `gorm:"type:uuid" json:"venue_id,omitempty"`
+	LocationName       *string    `gorm:"type:varchar(160)" json:"location_name,omitempty"`
+	Address            *string    `gorm:"type:text" json:"address,omitempty"`
+	Latitude           float64    `gorm:"type:decimal(9,6);not null" json:"latitude"`
+	Longitude          float64    `gorm:"type:decimal(9,6);not null" json:"longitude"`
+	Capacity           int        `gorm:"type:int;not null;check:capacity >= 1" json:"capacity"`
+	Description        *string    `gorm:"type:text" json:"description,omitempty"`
+	PriceCents         int        `gorm:"type:int;not null;default:0;check:price_cents >= 0" json:"price_cents"`
+	Currency           string     `gorm:"type:varchar(3);not null;default:'USD'" json:"currency"`
+	Visibility         string     `gorm:"type:text;not null;default:'public';check:visibility IN ('public','participants')" json:"visibility"`
+	Status             string     `gorm:"type:text;not null;default:'open';check:status IN ('draft','open','full','ongoing','completed','cancelled')" json:"status"`
+	PublishAt          *time.Time `gorm:"type:timestamptz" json:"publish_at,omitempty"`
+	PublishedAt        *time.Time `gorm:"type:timestamptz" json:"published_at,omitempty"`
+	CancellationReason *string    `gorm:"type:varchar(500)" json:"cancellation_reason,omitempty"`
+	CancelledAt        *time.Time `gorm:"type:timestamptz" json:"cancelled_at,omitempty"`
+	CancelledBy        *uuid.UUID `gorm:"type:uuid" json:"cancelled_by,omitempty"`
+	HiddenAt           *time.Time `gorm:"type:timestamptz" json:"hidden_at,omitempty"`
+	HiddenBy           *uuid.UUID `gorm:"type:uuid" json:"hidden_by,omitempty"`
+	HiddenReason       *string    `gorm:"type:varchar(500)" json:"hidden_reason,omitempty"`
+	CreatedAt          time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt          time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+
+	// LocalEventTime is EventTime in the event's time zone (not stored in DB)
+	LocalEventTime time.Time `gorm:"-" json:"local_event_time"`
 
 	// Relations (not stored in DB)
-	Creator      *User  `gorm:"foreignKey:CreatorID" json:"creator,omitempty"`
-	Participants []User `gorm:"many2many:event_participants;" json:"participants,omitempty"`
+	Creator      *User         `gorm:"foreignKey:CreatorID" json:"creator,omitempty"`
+	Venue        *Venue        `gorm:"foreignKey:VenueID" json:"venue,omitempty"`
+	CoHosts      []EventCoHost `gorm:"foreignKey:EventID" json:"cohosts,omitempty"`
+	Participants []User        `gorm:"many2many:event_participants;" json:"participants,omitempty"`
 }
 
 func (Event) TableName() string {
 	return "events"
 }
 
+// AfterFind fills in the local start time of loaded events
+func (e *Event) AfterFind(tx *gorm.DB) error {
+	e.Localize()
+	return nil
+}
+
+// Localize sets LocalEventTime from EventTime and TimeZone. Unknown zones fall back to UTC.
+func (e *Event) Localize() {
+	loc, err := time.LoadLocation(e.TimeZone)
+	if err != nil || e.TimeZone == "" {
+		loc = time.UTC
+	}
+	e.LocalEventTime = e.EventTime.In(loc)
+}
+
+// EndTime is when the event is over
+func (e *Event) EndTime() time.Time {
+	return e.EventTime.Add(time.Duration(e.DurationMin) * time.Minute)
+}
+
 // EventWithDistance extends Event with distance information
 type EventWithDistance struct {
 	Event
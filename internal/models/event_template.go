@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventTemplate holds the details an organizer reuses for recurring events.
+// Templates with a venue take their location from it whenever an event is created.
+type EventTemplate struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	Name         string     `gorm:"type:varchar(80);not null" json:"name"`
+	Title        string     `gorm:"type:varchar(120);not null" json:"title"`
+	SportType    string     `gorm:"type:varchar(50);not null" json:"sport_type"`
+	VenueID      *uuid.UUID `gorm:"type:uuid" json:"venue_id,omitempty"`
+	LocationName *string    `gorm:"type:varchar(160)" json:"location_name,omitempty"`
+	Address      *string    `gorm:"type:text" json:"address,omitempty"`
+	Latitude     float64    `gorm:"type:decimal(9,6);not null" json:"latitude"`
+	Longitude    float64    `gorm:"type:decimal(9,6);not null" json:"longitude"`
+	Capacity     int        `gorm:"type:int;not null;check:capacity >= 1" json:"capacity"`
+	Description  *string    `gorm:"type:text" json:"description,omitempty"`
+	PriceCents   int        `gorm:"type:int;not null;default:0;check:price_cents >= 0" json:"price_cents"`
+	Currency     string     `gorm:"type:varchar(3);not null;default:'USD'" json:"currency"`
+	Visibility   string     `gorm:"type:text;not null;default:'public';check:visibility IN ('public','participants')" json:"visibility"`
+	DurationMin  int        `gorm:"column:duration_minutes;type:int;not null;default:120;check:duration_minutes BETWEEN 15 AND 1440" json:"duration_minutes"`
+	TimeZone     string     `gorm:"type:varchar(64);not null;default:'UTC'" json:"time_zone"`
+	CreatedAt    time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+
+	// Relations (not stored in DB)
+	Venue *Venue `gorm:"foreignKey:VenueID" json:"venue,omitempty"`
+}
+
+func (EventTemplate) TableName() string {
+	return "event_templates"
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserFollow records that one user follows another
+type UserFollow struct {
+	FollowerID uuid.UUID `gorm:"type:uuid;primary_key" json:"follower_id"`
+	FolloweeID uuid.UUID `gorm:"type:uuid;primary_key;check:follower_id <> followee_id" json:"followee_id"`
+	CreatedAt  time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+
+	// Relations (not stored in DB)
+	Follower *User `gorm:"foreignKey:FollowerID" json:"follower,omitempty"`
+	Followee *User `gorm:"foreignKey:FolloweeID" json:"followee,omitempty"`
+}
+
+func (UserFollow) TableName() string {
+	return "user_follows"
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Job statuses
+const (
+	JobStatusPending = "pending"
+	JobStatusRunning = "running"
+	JobStatusDone    = "done"
+	JobStatusFailed  = "failed"
+)
+
+// Job is a unit of background work. A worker leases a due job by setting
+// LockedBy and LockedUntil; when the lease runs out without the job finishing,
+// another worker may pick it up again. UniqueKey keeps the same job from being
+// queued twice.
+type Job struct {
+	ID          uuid.UUID              `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Type        string                 `gorm:"type:varchar(60);not null" json:"type"`
+	Payload     map[string]interface{} `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"payload"`
+	UniqueKey   *string                `gorm:"type:text;uniqueIndex" json:"unique_key,omitempty"`
+	Status      string                 `gorm:"type:varchar(20);not null;default:'pending';check:status IN ('pending','running','done','failed')" json:"status"`
+	RunAt       time.Time              `gorm:"type:timestamptz;not null;default:now()" json:"run_at"`
+	Attempts    int                    `gorm:"not null;default:0" json:"attempts"`
+	MaxAttempts int                    `gorm:"not null;default:5" json:"max_attempts"`
+	LockedBy    *string                `gorm:"type:text" json:"locked_by,omitempty"`
+	LockedUntil *time.Time             `gorm:"type:timestamptz" json:"locked_until,omitempty"`
+	LastError   *string                `gorm:"type:text" json:"last_error,omitempty"`
+	CompletedAt *time.Time             `gorm:"type:timestamptz" json:"completed_at,omitempty"`
+	CreatedAt   time.Time              `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt   time.Time              `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+}
+
+func (Job) TableName() string {
+	return "jobs"
+}
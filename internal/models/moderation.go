@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Moderation actions admins take on reported content
+const (
+	ModerationActionWarn        = "warn"
+	ModerationActionHideEvent   = "hide_event"
+	ModerationActionUnhideEvent = "unhide_event"
+	ModerationActionHideComment = "hide_comment"
+	ModerationActionSuspendUser = "suspend_user"
+	ModerationActionBanUser     = "ban_user"
+	ModerationActionUnbanUser   = "unban_user"
+	ModerationActionDismiss     = "dismiss"
+)
+
+// ModerationAction records which admin took which action on what. Records are
+// never changed or deleted.
+type ModerationAction struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ReportID   *uuid.UUID `gorm:"type:uuid" json:"report_id,omitempty"`
+	AdminID    uuid.UUID  `gorm:"type:uuid;not null" json:"admin_id"`
+	Action     string     `gorm:"type:varchar(20);not null;check:action IN ('warn','hide_event','unhide_event','hide_comment','suspend_user','ban_user','unban_user','dismiss')" json:"action"`
+	TargetType string     `gorm:"type:varchar(10);not null" json:"target_type"`
+	TargetID   uuid.UUID  `gorm:"type:uuid;not null" json:"target_id"`
+	// UserID is the user the action is about: the reported user, or the author of
+	// the reported event or comment
+	UserID         *uuid.UUID `gorm:"type:uuid" json:"user_id,omitempty"`
+	Note           *string    `gorm:"type:varchar(1000)" json:"note,omitempty"`
+	SuspendedUntil *time.Time `gorm:"type:timestamptz" json:"suspended_until,omitempty"`
+	CreatedAt      time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+
+	// Relations (not stored in DB)
+	Admin *User `gorm:"foreignKey:AdminID" json:"admin,omitempty"`
+}
+
+func (ModerationAction) TableName() string {
+	return "moderation_actions"
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification types; users can switch each one off
+const (
+	NotificationEventCancelled     = "event_cancelled"
+	NotificationEventReinstated    = "event_reinstated"
+	NotificationEventRescheduled   = "event_rescheduled"
+	NotificationEventReminder      = "event_reminder"
+	NotificationEventFull          = "event_full"
+	NotificationParticipantJoined  = "participant_joined"
+	NotificationParticipantRemoved = "participant_removed"
+	NotificationCostReminder       = "cost_reminder"
+	NotificationNewFollower        = "new_follower"
+	NotificationModeration         = "moderation_notice"
+)
+
+// NotificationTypes lists every notification type in display order
+var NotificationTypes = []string{
+	NotificationEventCancelled,
+	NotificationEventReinstated,
+	NotificationEventRescheduled,
+	NotificationEventReminder,
+	NotificationEventFull,
+	NotificationParticipantJoined,
+	NotificationParticipantRemoved,
+	NotificationCostReminder,
+	NotificationNewFollower,
+	NotificationModeration,
+}
+
+// pushByDefault lists the types that also go to the user's devices unless switched off
+var pushByDefault = map[string]bool{
+	NotificationEventCancelled:   true,
+	NotificationEventReinstated:  true,
+	NotificationEventRescheduled: true,
+	NotificationEventReminder:    true,
+	NotificationCostReminder:     true,
+	NotificationModeration:       true,
+}
+
+// PushByDefault reports whether the type is pushed to devices when the user has no preference
+func PushByDefault(notificationType string) bool {
+	return pushByDefault[notificationType]
+}
+
+// Notification is an entry in a user's in-app inbox
+type Notification struct {
+	ID        uuid.UUID              `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID    uuid.UUID              `gorm:"type:uuid;not null" json:"user_id"`
+	Type      string                 `gorm:"type:varchar(40);not null" json:"type"`
+	EventID   *uuid.UUID             `gorm:"type:uuid" json:"event_id,omitempty"`
+	Title     string                 `gorm:"type:varchar(200);not null" json:"title"`
+	Body      string                 `gorm:"type:text;not null" json:"body"`
+	Data      map[string]interface{} `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"data"`
+	ReadAt    *time.Time             `gorm:"type:timestamptz" json:"read_at,omitempty"`
+	CreatedAt time.Time              `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// NotificationPreference records a user's choice for one notification type; types
+// without a row go to the inbox and are pushed when PushByDefault says so
+type NotificationPreference struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"-"`
+	Type      string    `gorm:"type:varchar(40);primaryKey" json:"type"`
+	InApp     bool      `gorm:"not null;default:true" json:"in_app"`
+	Push      bool      `gorm:"not null;default:false" json:"push"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+}
+
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
@@ -6,11 +6,25 @@ import (
 	"github.com/google/uuid"
 )
 
+// Participant statuses; joined participants and unexpired payment reservations count towards capacity
+const (
+	ParticipantStatusJoined         = "joined"
+	ParticipantStatusPendingPayment = "pending_payment"
+	ParticipantStatusRemoved        = "removed"
+	ParticipantStatusBanned         = "banned"
+)
+
 type EventParticipant struct {
-	ID       uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	EventID  uuid.UUID `gorm:"type:uuid;not null" json:"event_id"`
-	UserID   uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
-	JoinedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"joined_at"`
+	ID               uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID          uuid.UUID  `gorm:"type:uuid;not null" json:"event_id"`
+	UserID           uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	Status           string     `gorm:"type:text;not null;default:'joined';check:status IN ('joined','pending_payment','removed','banned')" json:"status"`
+	JoinedAt         time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"joined_at"`
+	PaymentExpiresAt *time.Time `gorm:"type:timestamptz" json:"payment_expires_at,omitempty"`
+	CheckedInAt      *time.Time `gorm:"type:timestamptz" json:"checked_in_at,omitempty"`
+	RemovalReason    *string    `gorm:"type:text" json:"removal_reason,omitempty"`
+	RemovedBy        *uuid.UUID `gorm:"type:uuid" json:"removed_by,omitempty"`
+	RemovedAt        *time.Time `gorm:"type:timestamptz" json:"removed_at,omitempty"`
 
 	// Relations
 	Event *Event `gorm:"foreignKey:EventID" json:"event,omitempty"`
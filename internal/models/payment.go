@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Payment statuses
+const (
+	PaymentStatusPending      = "pending"
+	PaymentStatusSucceeded    = "succeeded"
+	PaymentStatusFailed       = "failed"
+	PaymentStatusExpired      = "expired"
+	PaymentStatusRefunded     = "refunded"
+	PaymentStatusRefundFailed = "refund_failed"
+)
+
+type Payment struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID     uuid.UUID  `gorm:"type:uuid;not null" json:"event_id"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	Provider    string     `gorm:"type:varchar(30);not null" json:"provider"`
+	ProviderRef string     `gorm:"type:varchar(255);not null" json:"provider_ref"`
+	AmountCents int        `gorm:"type:int;not null;check:amount_cents > 0" json:"amount_cents"`
+	Currency    string     `gorm:"type:varchar(3);not null" json:"currency"`
+	Status      string     `gorm:"type:text;not null;default:'pending';check:status IN ('pending','succeeded','failed','expired','refunded','refund_failed')" json:"status"`
+	CheckoutURL *string    `gorm:"type:text" json:"checkout_url,omitempty"`
+	ExpiresAt   time.Time  `gorm:"type:timestamptz;not null" json:"expires_at"`
+	PaidAt      *time.Time `gorm:"type:timestamptz" json:"paid_at,omitempty"`
+	RefundedAt  *time.Time `gorm:"type:timestamptz" json:"refunded_at,omitempty"`
+	CreatedAt   time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+}
+
+func (Payment) TableName() string {
+	return "payments"
+}
+
+// PaymentWebhookEvent records provider callbacks that were already processed
+type PaymentWebhookEvent struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Provider    string    `gorm:"type:varchar(30);not null" json:"provider"`
+	ExternalID  string    `gorm:"type:varchar(255);not null" json:"external_id"`
+	Type        string    `gorm:"type:varchar(60);not null" json:"type"`
+	ProcessedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"processed_at"`
+}
+
+func (PaymentWebhookEvent) TableName() string {
+	return "payment_webhook_events"
+}
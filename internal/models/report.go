@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// What a report is about
+const (
+	ReportTargetUser    = "user"
+	ReportTargetEvent   = "event"
+	ReportTargetComment = "comment"
+)
+
+// Report categories
+const (
+	ReportCategorySpam          = "spam"
+	ReportCategoryHarassment    = "harassment"
+	ReportCategoryHate          = "hate"
+	ReportCategoryViolence      = "violence"
+	ReportCategoryInappropriate = "inappropriate"
+	ReportCategoryScam          = "scam"
+	ReportCategoryOther         = "other"
+)
+
+// ReportCategories lists every report category
+var ReportCategories = []string{
+	ReportCategorySpam,
+	ReportCategoryHarassment,
+	ReportCategoryHate,
+	ReportCategoryViolence,
+	ReportCategoryInappropriate,
+	ReportCategoryScam,
+	ReportCategoryOther,
+}
+
+// Report statuses. New reports wait in the admin moderation queue until an admin
+// claims them, and are closed as resolved (an action was taken) or dismissed.
+const (
+	ReportStatusOpen      = "open"
+	ReportStatusClaimed   = "claimed"
+	ReportStatusResolved  = "resolved"
+	ReportStatusDismissed = "dismissed"
+)
+
+// Report is a user's complaint about another user, an event or a comment
+type Report struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ReporterID uuid.UUID  `gorm:"type:uuid;not null" json:"reporter_id"`
+	TargetType string     `gorm:"type:varchar(10);not null;check:target_type IN ('user','event','comment')" json:"target_type"`
+	TargetID   uuid.UUID  `gorm:"type:uuid;not null" json:"target_id"`
+	Category   string     `gorm:"type:varchar(20);not null;check:category IN ('spam','harassment','hate','violence','inappropriate','scam','other')" json:"category"`
+	Details    *string    `gorm:"type:varchar(1000)" json:"details,omitempty"`
+	Status     string     `gorm:"type:varchar(20);not null;default:'open';check:status IN ('open','claimed','resolved','dismissed')" json:"status"`
+	ClaimedBy  *uuid.UUID `gorm:"type:uuid" json:"claimed_by,omitempty"`
+	ClaimedAt  *time.Time `gorm:"type:timestamptz" json:"claimed_at,omitempty"`
+	ResolvedBy *uuid.UUID `gorm:"type:uuid" json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time `gorm:"type:timestamptz" json:"resolved_at,omitempty"`
+	// Resolution is the moderation action that closed the report
+	Resolution *string   `gorm:"type:varchar(20)" json:"resolution,omitempty"`
+	CreatedAt  time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+
+	// Relations (not stored in DB)
+	Reporter *User `gorm:"foreignKey:ReporterID" json:"reporter,omitempty"`
+}
+
+func (Report) TableName() string {
+	return "reports"
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserSportSkill struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
+	SportType string    `gorm:"type:varchar(50);not null" json:"sport_type"`
+	Level     int       `gorm:"type:int;not null;check:level BETWEEN 1 AND 5" json:"level"`
+	CreatedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+}
+
+func (UserSportSkill) TableName() string {
+	return "user_sport_skills"
+}
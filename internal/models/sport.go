@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Sport struct {
+	ID              uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Slug            string            `gorm:"type:varchar(50);unique;not null" json:"slug"`
+	Names           map[string]string `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"names"`
+	Icon            *string           `gorm:"type:text" json:"icon,omitempty"`
+	DefaultTeamSize *int              `gorm:"type:int;check:default_team_size >= 1" json:"default_team_size,omitempty"`
+	Aliases         []string          `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"aliases"`
+	IsActive        bool              `gorm:"type:boolean;not null;default:true" json:"is_active"`
+	CreatedAt       time.Time         `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt       time.Time         `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+}
+
+func (Sport) TableName() string {
+	return "sports"
+}
+
+// Name returns the localized name for lang, falling back to English and then the slug
+func (s *Sport) Name(lang string) string {
+	if name, ok := s.Names[lang]; ok && name != "" {
+		return name
+	}
+	if name, ok := s.Names["en"]; ok && name != "" {
+		return name
+	}
+	return s.Slug
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventTeam struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID   uuid.UUID `gorm:"type:uuid;not null" json:"event_id"`
+	Name      string    `gorm:"type:varchar(60);not null" json:"name"`
+	Color     string    `gorm:"type:varchar(30);not null" json:"color"`
+	Position  int       `gorm:"type:int;not null" json:"position"`
+	CreatedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+
+	// Relations
+	Members []EventTeamMember `gorm:"foreignKey:TeamID" json:"members,omitempty"`
+}
+
+func (EventTeam) TableName() string {
+	return "event_teams"
+}
+
+type EventTeamMember struct {
+	ID      uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TeamID  uuid.UUID `gorm:"type:uuid;not null" json:"team_id"`
+	EventID uuid.UUID `gorm:"type:uuid;not null" json:"event_id"`
+	UserID  uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
+
+	// Relations
+	User *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+func (EventTeamMember) TableName() string {
+	return "event_team_members"
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tournament formats and statuses
+const (
+	TournamentFormatSingleElimination = "single_elimination"
+	TournamentFormatRoundRobin        = "round_robin"
+
+	TournamentStatusRegistration = "registration"
+	TournamentStatusInProgress   = "in_progress"
+	TournamentStatusCompleted    = "completed"
+
+	MatchStatusScheduled = "scheduled"
+	MatchStatusCompleted = "completed"
+	MatchStatusBye       = "bye"
+)
+
+type Tournament struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID      uuid.UUID  `gorm:"type:uuid;unique;not null" json:"event_id"`
+	Format       string     `gorm:"type:text;not null;check:format IN ('single_elimination','round_robin')" json:"format"`
+	Status       string     `gorm:"type:text;not null;default:'registration';check:status IN ('registration','in_progress','completed')" json:"status"`
+	MaxTeams     int        `gorm:"type:int;not null;check:max_teams >= 2" json:"max_teams"`
+	PointsWin    int        `gorm:"type:int;not null;default:3" json:"points_win"`
+	PointsDraw   int        `gorm:"type:int;not null;default:1" json:"points_draw"`
+	PointsLoss   int        `gorm:"type:int;not null;default:0" json:"points_loss"`
+	WinnerTeamID *uuid.UUID `gorm:"type:uuid" json:"winner_team_id,omitempty"`
+	CreatedAt    time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+
+	// Relations
+	Event *Event `gorm:"foreignKey:EventID" json:"event,omitempty"`
+}
+
+func (Tournament) TableName() string {
+	return "tournaments"
+}
+
+type TournamentTeam struct {
+	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TournamentID uuid.UUID `gorm:"type:uuid;not null" json:"tournament_id"`
+	Name         string    `gorm:"type:varchar(60);not null" json:"name"`
+	CaptainID    uuid.UUID `gorm:"type:uuid;not null" json:"captain_id"`
+	Seed         int       `gorm:"type:int;not null" json:"seed"`
+	CreatedAt    time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+
+	// Relations
+	Members []TournamentTeamMember `gorm:"foreignKey:TeamID" json:"members,omitempty"`
+}
+
+func (TournamentTeam) TableName() string {
+	return "tournament_teams"
+}
+
+type TournamentTeamMember struct {
+	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TeamID       uuid.UUID `gorm:"type:uuid;not null" json:"team_id"`
+	TournamentID uuid.UUID `gorm:"type:uuid;not null" json:"tournament_id"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
+}
+
+func (TournamentTeamMember) TableName() string {
+	return "tournament_team_members"
+}
+
+type TournamentMatch struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	TournamentID uuid.UUID  `gorm:"type:uuid;not null" json:"tournament_id"`
+	Round        int        `gorm:"type:int;not null" json:"round"`
+	Position     int        `gorm:"type:int;not null" json:"position"`
+	HomeTeamID   *uuid.UUID `gorm:"type:uuid" json:"home_team_id,omitempty"`
+	AwayTeamID   *uuid.UUID `gorm:"type:uuid" json:"away_team_id,omitempty"`
+	HomeScore    *int       `gorm:"type:int" json:"home_score,omitempty"`
+	AwayScore    *int       `gorm:"type:int" json:"away_score,omitempty"`
+	WinnerTeamID *uuid.UUID `gorm:"type:uuid" json:"winner_team_id,omitempty"`
+	Status       string     `gorm:"type:text;not null;default:'scheduled';check:status IN ('scheduled','completed','bye')" json:"status"`
+	ScheduledAt  *time.Time `gorm:"type:timestamptz" json:"scheduled_at,omitempty"`
+	NextMatchID  *uuid.UUID `gorm:"type:uuid" json:"next_match_id,omitempty"`
+	NextSlot     *string    `gorm:"type:text" json:"next_slot,omitempty"`
+	CreatedAt    time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+}
+
+func (TournamentMatch) TableName() string {
+	return "tournament_matches"
+}
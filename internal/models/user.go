@@ -6,14 +6,48 @@ import (
 	"github.com/google/uuid"
 )
 
+// Profile visibility decides who sees more than a user's name and avatar
+const (
+	ProfileVisibilityPublic    = "public"
+	ProfileVisibilityFollowers = "followers"
+	ProfileVisibilityPrivate   = "private"
+)
+
+// Account statuses. Suspended users are let back in once SuspendedUntil has passed;
+// banned users stay out until an admin lifts the ban.
+const (
+	UserStatusActive    = "active"
+	UserStatusSuspended = "suspended"
+	UserStatusBanned    = "banned"
+)
+
 type User struct {
 	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
 	Name         string    `gorm:"type:text;not null" json:"name"`
 	Email        string    `gorm:"type:text;unique;not null" json:"email"`
 	PasswordHash string    `gorm:"type:text;not null" json:"-"`
 	Role         string    `gorm:"type:text;not null;default:'user';check:role IN ('user','admin')" json:"role"`
-	CreatedAt    time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
-	UpdatedAt    time.Time `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+
+	// Profile
+	AvatarURL      *string  `gorm:"type:varchar(500)" json:"avatar_url,omitempty"`
+	Bio            *string  `gorm:"type:varchar(500)" json:"bio,omitempty"`
+	FavoriteSports []string `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"favorite_sports"`
+	HomeArea       *string  `gorm:"type:varchar(120)" json:"home_area,omitempty"`
+
+	// Privacy settings
+	ProfileVisibility string `gorm:"type:text;not null;default:'public';check:profile_visibility IN ('public','followers','private')" json:"profile_visibility"`
+	ShowHomeArea      bool   `gorm:"not null;default:true" json:"show_home_area"`
+	ShowStats         bool   `gorm:"not null;default:true" json:"show_stats"`
+
+	// Account status
+	Status          string     `gorm:"type:varchar(20);not null;default:'active';check:status IN ('active','suspended','banned')" json:"status"`
+	SuspendedUntil  *time.Time `gorm:"type:timestamptz" json:"suspended_until,omitempty"`
+	StatusReason    *string    `gorm:"type:varchar(500)" json:"status_reason,omitempty"`
+	StatusChangedAt *time.Time `gorm:"type:timestamptz" json:"status_changed_at,omitempty"`
+	StatusChangedBy *uuid.UUID `gorm:"type:uuid" json:"status_changed_by,omitempty"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
 }
 
 func (User) TableName() string {
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Venue struct {
+	ID           uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Name         string            `gorm:"type:varchar(160);not null" json:"name"`
+	Address      *string           `gorm:"type:text" json:"address,omitempty"`
+	Latitude     float64           `gorm:"type:decimal(9,6);not null" json:"latitude"`
+	Longitude    float64           `gorm:"type:decimal(9,6);not null" json:"longitude"`
+	Sports       []string          `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"sports"`
+	Amenities    []string          `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"amenities"`
+	OpeningHours map[string]string `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"opening_hours"`
+	CreatedBy    *uuid.UUID        `gorm:"type:uuid" json:"created_by,omitempty"`
+	CreatedAt    time.Time         `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt    time.Time         `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+}
+
+func (Venue) TableName() string {
+	return "venues"
+}
+
+// SupportsSport reports whether the venue lists the sport; venues without a sports list accept any sport
+func (v *Venue) SupportsSport(slug string) bool {
+	if len(v.Sports) == 0 {
+		return true
+	}
+	for _, sport := range v.Sports {
+		if sport == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// VenueWithDistance extends Venue with distance information
+type VenueWithDistance struct {
+	Venue
+	DistanceKM *float64 `json:"distance_km,omitempty"`
+}
@@ -0,0 +1,80 @@
+package payments
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// FakeSignatureHeader carries the hex HMAC-SHA256 of the webhook body
+const FakeSignatureHeader = "X-Fake-Signature"
+
+// FakeProvider accepts every payment and signs its webhooks with a shared secret.
+// It is meant for local development and tests only.
+type FakeProvider struct {
+	secret []byte
+}
+
+func NewFakeProvider(secret string) *FakeProvider {
+	return &FakeProvider{secret: []byte(secret)}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) CreateIntent(req IntentRequest) (*Intent, error) {
+	ref := "fake_pi_" + uuid.New().String()
+	return &Intent{
+		ProviderRef: ref,
+		CheckoutURL: "/payments/fake/" + ref,
+	}, nil
+}
+
+func (p *FakeProvider) CancelIntent(providerRef string) error {
+	return nil
+}
+
+func (p *FakeProvider) Refund(providerRef string, amountCents int) error {
+	return nil
+}
+
+func (p *FakeProvider) ParseWebhook(payload []byte, header http.Header) (*WebhookEvent, error) {
+	signature, err := hex.DecodeString(header.Get(FakeSignatureHeader))
+	if err != nil || !hmac.Equal(signature, p.sign(payload)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// SimulateWebhook builds the signed callback the provider would send when the
+// user completes or abandons checkout
+func (p *FakeProvider) SimulateWebhook(providerRef, eventType string) ([]byte, http.Header, error) {
+	payload, err := json.Marshal(WebhookEvent{
+		ID:          "evt_" + uuid.New().String(),
+		Type:        eventType,
+		ProviderRef: providerRef,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := http.Header{}
+	header.Set(FakeSignatureHeader, hex.EncodeToString(p.sign(payload)))
+	return payload, header, nil
+}
+
+func (p *FakeProvider) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,44 @@
+package payments_test
+
+import (
+	"errors"
+	"playspotter/internal/payments"
+	"testing"
+)
+
+func TestFakeProviderWebhookSignature(t *testing.T) {
+	provider := payments.NewFakeProvider("secret")
+
+	payload, header, err := provider.SimulateWebhook("fake_pi_1", payments.EventPaymentSucceeded)
+	if err != nil {
+		t.Fatalf("SimulateWebhook failed: %v", err)
+	}
+
+	t.Run("Valid signature", func(t *testing.T) {
+		event, err := provider.ParseWebhook(payload, header)
+		if err != nil {
+			t.Fatalf("Expected valid webhook, got %v", err)
+		}
+		if event.ProviderRef != "fake_pi_1" || event.Type != payments.EventPaymentSucceeded {
+			t.Errorf("Unexpected event %+v", event)
+		}
+		if event.ID == "" {
+			t.Error("Expected a delivery ID")
+		}
+	})
+
+	t.Run("Tampered payload", func(t *testing.T) {
+		tampered := append([]byte{}, payload...)
+		tampered[len(tampered)-2] = 'X'
+		if _, err := provider.ParseWebhook(tampered, header); !errors.Is(err, payments.ErrInvalidSignature) {
+			t.Errorf("Expected ErrInvalidSignature, got %v", err)
+		}
+	})
+
+	t.Run("Wrong secret", func(t *testing.T) {
+		other := payments.NewFakeProvider("other")
+		if _, err := other.ParseWebhook(payload, header); !errors.Is(err, payments.ErrInvalidSignature) {
+			t.Errorf("Expected ErrInvalidSignature, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package payments
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Webhook event types every provider maps its callbacks onto
+const (
+	EventPaymentSucceeded = "payment.succeeded"
+	EventPaymentFailed    = "payment.failed"
+)
+
+// ErrInvalidSignature is returned when a webhook payload fails verification
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// IntentRequest describes a payment to collect; Reference is our payment ID
+type IntentRequest struct {
+	Reference   string
+	AmountCents int
+	Currency    string
+	Description string
+	ExpiresAt   time.Time
+}
+
+// Intent is the provider's handle for a payment the user still has to complete
+type Intent struct {
+	ProviderRef string
+	CheckoutURL string
+}
+
+// WebhookEvent is a verified provider callback; ID is unique per delivery and is
+// used to process each callback only once
+type WebhookEvent struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	ProviderRef string `json:"provider_ref"`
+}
+
+// PaymentProvider is implemented by each payment gateway integration
+type PaymentProvider interface {
+	Name() string
+	CreateIntent(req IntentRequest) (*Intent, error)
+	// CancelIntent closes a checkout the user has not completed
+	CancelIntent(providerRef string) error
+	Refund(providerRef string, amountCents int) error
+	ParseWebhook(payload []byte, header http.Header) (*WebhookEvent, error)
+}
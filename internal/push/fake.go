@@ -0,0 +1,50 @@
+package push
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// FakeSender logs and records messages instead of delivering them. Tokens can be
+// marked invalid to exercise pruning. It is meant for local development and tests only.
+type FakeSender struct {
+	mu      sync.Mutex
+	sent    []Message
+	invalid map[string]bool
+}
+
+func NewFakeSender() *FakeSender {
+	return &FakeSender{invalid: make(map[string]bool)}
+}
+
+func (s *FakeSender) Name() string {
+	return "fake"
+}
+
+func (s *FakeSender) Send(ctx context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.invalid[msg.Token] {
+		return ErrInvalidToken
+	}
+
+	s.sent = append(s.sent, msg)
+	log.Printf("push(fake): %q to %s", msg.Title, msg.Token)
+	return nil
+}
+
+// Invalidate makes every later send to the token fail with ErrInvalidToken
+func (s *FakeSender) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.invalid[token] = true
+}
+
+// Sent returns the messages delivered so far
+func (s *FakeSender) Sent() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.sent...)
+}
@@ -0,0 +1,120 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultFCMEndpoint is Firebase Cloud Messaging's API host
+const DefaultFCMEndpoint = "https://fcm.googleapis.com"
+
+// FCMSender sends through the FCM HTTP v1 API, or any service speaking the same
+// protocol. The access token is sent as a bearer token; obtaining and refreshing
+// it (e.g. from a service account) is left to the deployment.
+type FCMSender struct {
+	endpoint    string
+	projectID   string
+	accessToken string
+	client      *http.Client
+}
+
+func NewFCMSender(endpoint, projectID, accessToken string) *FCMSender {
+	if endpoint == "" {
+		endpoint = DefaultFCMEndpoint
+	}
+	return &FCMSender{
+		endpoint:    strings.TrimRight(endpoint, "/"),
+		projectID:   projectID,
+		accessToken: accessToken,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *FCMSender) Name() string {
+	return "fcm"
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func (s *FCMSender) Send(ctx context.Context, msg Message) error {
+	payload, err := json.Marshal(fcmRequest{Message: fcmMessage{
+		Token:        msg.Token,
+		Notification: fcmNotification{Title: msg.Title, Body: msg.Body},
+		Data:         msg.Data,
+	}})
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+
+	url := fmt.Sprintf("%s/v1/projects/%s/messages:send", s.endpoint, s.projectID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.accessToken)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	var fcmErr fcmErrorResponse
+	_ = json.Unmarshal(body, &fcmErr)
+
+	code := fcmErr.Error.Status
+	for _, detail := range fcmErr.Error.Details {
+		if detail.ErrorCode != "" {
+			code = detail.ErrorCode
+		}
+	}
+	err = fmt.Errorf("fcm: %s: %s", resp.Status, fcmErr.Error.Message)
+
+	// Only errors about the token itself drop it; any other 404 (such as a wrong
+	// project ID) is a configuration problem and must not drop every device
+	switch {
+	case code == "UNREGISTERED":
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	case code == "INVALID_ARGUMENT" && strings.Contains(strings.ToLower(fcmErr.Error.Message), "registration token"):
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return err
+	default:
+		return &PermanentError{Err: err}
+	}
+}
@@ -0,0 +1,146 @@
+package push_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"playspotter/internal/push"
+	"testing"
+	"time"
+)
+
+// flakySender fails with the given errors before succeeding
+type flakySender struct {
+	errs  []error
+	calls int
+}
+
+func (s *flakySender) Name() string { return "flaky" }
+
+func (s *flakySender) Send(ctx context.Context, msg push.Message) error {
+	s.calls++
+	if s.calls <= len(s.errs) {
+		return s.errs[s.calls-1]
+	}
+	return nil
+}
+
+func TestDeliverRetries(t *testing.T) {
+	policy := push.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	msg := push.Message{Token: "device"}
+	temporary := errors.New("temporary")
+
+	t.Run("Recovers from temporary failures", func(t *testing.T) {
+		sender := &flakySender{errs: []error{temporary, temporary}}
+		if err := push.Deliver(context.Background(), sender, msg, policy); err != nil {
+			t.Fatalf("Expected delivery to succeed, got %v", err)
+		}
+		if sender.calls != 3 {
+			t.Errorf("Expected 3 attempts, got %d", sender.calls)
+		}
+	})
+
+	t.Run("Gives up after max attempts", func(t *testing.T) {
+		sender := &flakySender{errs: []error{temporary, temporary, temporary, temporary}}
+		if err := push.Deliver(context.Background(), sender, msg, policy); !errors.Is(err, temporary) {
+			t.Fatalf("Expected the last error, got %v", err)
+		}
+		if sender.calls != 3 {
+			t.Errorf("Expected 3 attempts, got %d", sender.calls)
+		}
+	})
+
+	t.Run("Does not retry invalid tokens", func(t *testing.T) {
+		sender := &flakySender{errs: []error{push.ErrInvalidToken}}
+		if err := push.Deliver(context.Background(), sender, msg, policy); !errors.Is(err, push.ErrInvalidToken) {
+			t.Fatalf("Expected ErrInvalidToken, got %v", err)
+		}
+		if sender.calls != 1 {
+			t.Errorf("Expected 1 attempt, got %d", sender.calls)
+		}
+	})
+
+	t.Run("Does not retry permanent errors", func(t *testing.T) {
+		sender := &flakySender{errs: []error{&push.PermanentError{Err: temporary}}}
+		_ = push.Deliver(context.Background(), sender, msg, policy)
+		if sender.calls != 1 {
+			t.Errorf("Expected 1 attempt, got %d", sender.calls)
+		}
+	})
+}
+
+func TestFCMSender(t *testing.T) {
+	var status int
+	var response string
+	var received struct {
+		Message struct {
+			Token        string `json:"token"`
+			Notification struct {
+				Title string `json:"title"`
+			} `json:"notification"`
+		} `json:"message"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/demo/messages:send" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("Unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	sender := push.NewFCMSender(server.URL, "demo", "secret")
+	msg := push.Message{Token: "device", Title: "Event cancelled", Body: "Sorry"}
+
+	t.Run("Success", func(t *testing.T) {
+		status, response = http.StatusOK, `{"name":"projects/demo/messages/1"}`
+		if err := sender.Send(context.Background(), msg); err != nil {
+			t.Fatalf("Expected success, got %v", err)
+		}
+		if received.Message.Token != "device" || received.Message.Notification.Title != "Event cancelled" {
+			t.Errorf("Unexpected payload %+v", received)
+		}
+	})
+
+	t.Run("Unregistered token", func(t *testing.T) {
+		status = http.StatusNotFound
+		response = `{"error":{"status":"NOT_FOUND","message":"Requested entity was not found.","details":[{"errorCode":"UNREGISTERED"}]}}`
+		if err := sender.Send(context.Background(), msg); !errors.Is(err, push.ErrInvalidToken) {
+			t.Fatalf("Expected ErrInvalidToken, got %v", err)
+		}
+	})
+
+	t.Run("Unknown project is permanent", func(t *testing.T) {
+		status = http.StatusNotFound
+		response = `{"error":{"status":"NOT_FOUND","message":"Requested entity was not found."}}`
+		err := sender.Send(context.Background(), msg)
+		var permanent *push.PermanentError
+		if !errors.As(err, &permanent) || errors.Is(err, push.ErrInvalidToken) {
+			t.Fatalf("Expected a permanent error that keeps the token, got %v", err)
+		}
+	})
+
+	t.Run("Server error is temporary", func(t *testing.T) {
+		status, response = http.StatusServiceUnavailable, `{"error":{"status":"UNAVAILABLE"}}`
+		err := sender.Send(context.Background(), msg)
+		var permanent *push.PermanentError
+		if err == nil || errors.As(err, &permanent) || errors.Is(err, push.ErrInvalidToken) {
+			t.Fatalf("Expected a temporary error, got %v", err)
+		}
+	})
+
+	t.Run("Rejected request is permanent", func(t *testing.T) {
+		status, response = http.StatusForbidden, `{"error":{"status":"PERMISSION_DENIED","message":"denied"}}`
+		var permanent *push.PermanentError
+		if err := sender.Send(context.Background(), msg); !errors.As(err, &permanent) {
+			t.Fatalf("Expected a permanent error, got %v", err)
+		}
+	})
+}
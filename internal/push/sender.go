@@ -0,0 +1,80 @@
+// Package push delivers notifications to users' devices.
+package push
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInvalidToken is returned when the provider no longer accepts a device token;
+// the token should be forgotten rather than retried
+var ErrInvalidToken = errors.New("invalid device token")
+
+// Message is a notification for one device
+type Message struct {
+	Token string
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// PushSender is implemented by each push provider integration
+type PushSender interface {
+	Name() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// PermanentError wraps a failure that retrying will not fix, such as a rejected payload
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// RetryPolicy controls how often a failed delivery is attempted again; the wait
+// doubles after every attempt starting from BaseDelay
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+// DefaultRetryPolicy makes three attempts, one, two and four seconds apart at most
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second}
+
+// Deliver sends the message, retrying temporary failures with exponential backoff.
+// Invalid tokens and permanent errors are returned straight away.
+func Deliver(ctx context.Context, sender PushSender, msg Message, policy RetryPolicy) error {
+	attempts := policy.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	delay := policy.BaseDelay
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = sender.Send(ctx, msg)
+		if err == nil {
+			return nil
+		}
+
+		var permanent *PermanentError
+		if errors.Is(err, ErrInvalidToken) || errors.As(err, &permanent) || attempt == attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return err
+}
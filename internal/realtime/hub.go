@@ -0,0 +1,227 @@
+// Package realtime fans event updates out to clients that keep a stream open.
+//
+// Each replica keeps its own subscriptions in a Hub. With a Relay, published
+// updates go through a shared channel (Postgres LISTEN/NOTIFY) and every replica,
+// the publishing one included, delivers them to its local subscribers.
+package realtime
+
+import (
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Update types sent to subscribers
+const (
+	UpdateParticipantJoined = "participant_joined"
+	UpdateParticipantLeft   = "participant_left"
+	UpdateStatusChanged     = "status_changed"
+	UpdateEventUpdated      = "event_updated"
+	UpdateCommentPosted     = "comment_posted"
+	UpdateCommentEdited     = "comment_edited"
+	UpdateCommentDeleted    = "comment_deleted"
+)
+
+// subscriptionBuffer is how many updates a subscriber may fall behind before it is dropped
+const subscriptionBuffer = 64
+
+// Update is a change to an event. Location and Private only route the update and
+// are not sent to clients.
+type Update struct {
+	Type    string                 `json:"type"`
+	EventID uuid.UUID              `json:"event_id"`
+	Data    map[string]interface{} `json:"data,omitempty"`
+	At      time.Time              `json:"at"`
+
+	Latitude  float64 `json:"-"`
+	Longitude float64 `json:"-"`
+	// Private updates are only delivered to event subscribers allowed to see them
+	Private bool `json:"-"`
+}
+
+// Area is a circle on the map, used to follow the events of a feed
+type Area struct {
+	Latitude   float64
+	Longitude  float64
+	DistanceKm float64
+}
+
+// Contains reports whether the point lies within the area
+func (a Area) Contains(lat, lng float64) bool {
+	return distanceKm(a.Latitude, a.Longitude, lat, lng) <= a.DistanceKm
+}
+
+// Subscription receives the updates matching either one event or an area. The
+// Updates channel is closed when the subscription ends, including when the
+// subscriber fell too far behind and should reconnect.
+type Subscription struct {
+	eventID *uuid.UUID
+	area    *Area
+	private bool
+
+	hub     *Hub
+	updates chan Update
+	closed  bool
+}
+
+// Updates returns the channel updates are delivered on
+func (s *Subscription) Updates() <-chan Update {
+	return s.updates
+}
+
+// Close ends the subscription
+func (s *Subscription) Close() {
+	s.hub.remove(s)
+}
+
+func (s *Subscription) matches(update Update) bool {
+	if s.eventID != nil {
+		return *s.eventID == update.EventID && (!update.Private || s.private)
+	}
+	return !update.Private && s.area.Contains(update.Latitude, update.Longitude)
+}
+
+// Relay carries published updates to the hub of every replica, which hands them
+// to Hub.Deliver
+type Relay interface {
+	Send(update Update) error
+}
+
+// Hub keeps the open subscriptions of this process
+type Hub struct {
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	closed        bool
+	relay         Relay
+}
+
+func NewHub() *Hub {
+	return &Hub{subscriptions: make(map[*Subscription]struct{})}
+}
+
+// SubscribeEvent follows one event; private updates (such as comments on
+// participants-only events) are included when includePrivate is set
+func (h *Hub) SubscribeEvent(eventID uuid.UUID, includePrivate bool) *Subscription {
+	return h.add(&Subscription{eventID: &eventID, private: includePrivate})
+}
+
+// SubscribeArea follows the public updates of every event inside the area
+func (h *Hub) SubscribeArea(area Area) *Subscription {
+	return h.add(&Subscription{area: &area})
+}
+
+// UseRelay sends published updates through the relay instead of delivering them
+// directly; set it before the hub is used
+func (h *Hub) UseRelay(relay Relay) {
+	h.relay = relay
+}
+
+// Publish sends the update to the subscribers of every replica. When the relay
+// cannot take it, only this replica's subscribers get it.
+func (h *Hub) Publish(update Update) {
+	if update.At.IsZero() {
+		update.At = time.Now().UTC()
+	}
+
+	if h.relay != nil {
+		err := h.relay.Send(update)
+		if err == nil {
+			return
+		}
+		log.Printf("realtime: relaying %s update for event %s failed, delivering locally: %v", update.Type, update.EventID, err)
+	}
+	h.Deliver(update)
+}
+
+// Deliver hands the update to every matching subscriber of this replica without
+// blocking. Subscribers whose buffer is full are closed so they reconnect and reload.
+func (h *Hub) Deliver(update Update) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscriptions {
+		if !sub.matches(update) {
+			continue
+		}
+		select {
+		case sub.updates <- update:
+		default:
+			h.closeLocked(sub)
+		}
+	}
+}
+
+// Close ends every subscription and rejects new ones; used on shutdown
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subscriptions {
+		h.closeLocked(sub)
+	}
+}
+
+// Reset ends every subscription but keeps accepting new ones. Relays call it when
+// updates may have been missed, so clients reconnect and reload.
+func (h *Hub) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscriptions {
+		h.closeLocked(sub)
+	}
+}
+
+// Count returns the number of open subscriptions
+func (h *Hub) Count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscriptions)
+}
+
+func (h *Hub) add(sub *Subscription) *Subscription {
+	sub.hub = h
+	sub.updates = make(chan Update, subscriptionBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		sub.closed = true
+		close(sub.updates)
+		return sub
+	}
+	h.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+func (h *Hub) remove(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closeLocked(sub)
+}
+
+func (h *Hub) closeLocked(sub *Subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	delete(h.subscriptions, sub)
+	close(sub.updates)
+}
+
+// distanceKm is the great-circle distance between two points
+func distanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadiusKm = 6371
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,161 @@
+package realtime_test
+
+import (
+	"playspotter/internal/realtime"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func receive(sub *realtime.Subscription) (realtime.Update, bool) {
+	select {
+	case update, ok := <-sub.Updates():
+		return update, ok
+	default:
+		return realtime.Update{}, false
+	}
+}
+
+func TestHubRouting(t *testing.T) {
+	hub := realtime.NewHub()
+	eventID := uuid.New()
+
+	follower := hub.SubscribeEvent(eventID, false)
+	insider := hub.SubscribeEvent(eventID, true)
+	other := hub.SubscribeEvent(uuid.New(), true)
+	nearby := hub.SubscribeArea(realtime.Area{Latitude: 52.52, Longitude: 13.405, DistanceKm: 10})
+	farAway := hub.SubscribeArea(realtime.Area{Latitude: 48.8566, Longitude: 2.3522, DistanceKm: 10})
+
+	hub.Publish(realtime.Update{Type: realtime.UpdateParticipantJoined, EventID: eventID, Latitude: 52.5, Longitude: 13.4})
+
+	t.Run("Public update", func(t *testing.T) {
+		for name, sub := range map[string]*realtime.Subscription{"follower": follower, "insider": insider, "nearby": nearby} {
+			update, ok := receive(sub)
+			if !ok || update.Type != realtime.UpdateParticipantJoined {
+				t.Errorf("Expected %s to receive the update", name)
+			}
+			if update.At.IsZero() {
+				t.Errorf("Expected %s update to be timestamped", name)
+			}
+		}
+		for name, sub := range map[string]*realtime.Subscription{"other": other, "farAway": farAway} {
+			if _, ok := receive(sub); ok {
+				t.Errorf("Expected %s not to receive the update", name)
+			}
+		}
+	})
+
+	hub.Publish(realtime.Update{Type: realtime.UpdateCommentPosted, EventID: eventID, Latitude: 52.5, Longitude: 13.4, Private: true})
+
+	t.Run("Private update", func(t *testing.T) {
+		if _, ok := receive(insider); !ok {
+			t.Error("Expected insider to receive the private update")
+		}
+		if _, ok := receive(follower); ok {
+			t.Error("Expected follower not to receive the private update")
+		}
+		if _, ok := receive(nearby); ok {
+			t.Error("Expected area subscribers never to receive private updates")
+		}
+	})
+}
+
+func TestHubDropsSlowSubscribers(t *testing.T) {
+	hub := realtime.NewHub()
+	eventID := uuid.New()
+	sub := hub.SubscribeEvent(eventID, false)
+
+	for i := 0; i < 1000; i++ {
+		hub.Publish(realtime.Update{Type: realtime.UpdateEventUpdated, EventID: eventID})
+	}
+
+	if hub.Count() != 0 {
+		t.Fatalf("Expected slow subscriber to be dropped, %d still open", hub.Count())
+	}
+
+	drained := 0
+	for range sub.Updates() {
+		drained++
+	}
+	if drained == 0 {
+		t.Error("Expected buffered updates to remain readable before the channel closes")
+	}
+
+	// Closing an already dropped subscription is a no-op
+	sub.Close()
+}
+
+func TestHubClose(t *testing.T) {
+	hub := realtime.NewHub()
+	sub := hub.SubscribeArea(realtime.Area{DistanceKm: 5})
+	hub.Close()
+
+	if _, ok := <-sub.Updates(); ok {
+		t.Error("Expected subscription to be closed")
+	}
+
+	late := hub.SubscribeEvent(uuid.New(), false)
+	if _, ok := <-late.Updates(); ok {
+		t.Error("Expected subscriptions after Close to be closed immediately")
+	}
+}
+
+// loopbackRelay records relayed updates, or fails when err is set
+type loopbackRelay struct {
+	sent []realtime.Update
+	err  error
+}
+
+func (r *loopbackRelay) Send(update realtime.Update) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.sent = append(r.sent, update)
+	return nil
+}
+
+func TestHubRelay(t *testing.T) {
+	hub := realtime.NewHub()
+	relay := &loopbackRelay{}
+	hub.UseRelay(relay)
+	eventID := uuid.New()
+	sub := hub.SubscribeEvent(eventID, false)
+
+	hub.Publish(realtime.Update{Type: realtime.UpdateStatusChanged, EventID: eventID})
+	if len(relay.sent) != 1 {
+		t.Fatalf("Expected the update to be relayed, got %d", len(relay.sent))
+	}
+	if _, ok := receive(sub); ok {
+		t.Error("Expected relayed updates to arrive through Deliver only")
+	}
+
+	hub.Deliver(relay.sent[0])
+	if _, ok := receive(sub); !ok {
+		t.Error("Expected the delivered update to reach the subscriber")
+	}
+
+	relay.err = realtime.ErrUpdateTooLarge
+	hub.Publish(realtime.Update{Type: realtime.UpdateStatusChanged, EventID: eventID})
+	if _, ok := receive(sub); !ok {
+		t.Error("Expected a local delivery when the relay fails")
+	}
+}
+
+func TestHubReset(t *testing.T) {
+	hub := realtime.NewHub()
+	sub := hub.SubscribeEvent(uuid.New(), false)
+
+	hub.Reset()
+	if _, ok := <-sub.Updates(); ok {
+		t.Error("Expected the subscription to be closed")
+	}
+	if hub.Count() != 0 {
+		t.Errorf("Expected no subscriptions, got %d", hub.Count())
+	}
+
+	again := hub.SubscribeEvent(uuid.New(), false)
+	defer again.Close()
+	if hub.Count() != 1 {
+		t.Error("Expected the hub to accept subscriptions after a reset")
+	}
+}
@@ -0,0 +1,110 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"gorm.io/gorm"
+)
+
+// notifyChannel is the Postgres channel replicas exchange updates on
+const notifyChannel = "realtime_updates"
+
+// maxNotifyPayload stays below the 8000 byte limit Postgres puts on NOTIFY payloads
+const maxNotifyPayload = 7900
+
+// listenRetryDelay is how long the listener waits before reconnecting
+const listenRetryDelay = 5 * time.Second
+
+// ErrUpdateTooLarge is returned for updates that do not fit in a NOTIFY payload
+var ErrUpdateTooLarge = errors.New("update is too large to relay")
+
+// PostgresRelay shares updates between replicas with Postgres LISTEN/NOTIFY on the
+// application database
+type PostgresRelay struct {
+	db          *gorm.DB
+	databaseURL string
+}
+
+func NewPostgresRelay(db *gorm.DB, databaseURL string) *PostgresRelay {
+	return &PostgresRelay{db: db, databaseURL: databaseURL}
+}
+
+// relayedUpdate carries the routing fields that Update keeps out of client JSON
+type relayedUpdate struct {
+	Update    Update  `json:"update"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Private   bool    `json:"private"`
+}
+
+// Send notifies every listening replica of the update
+func (r *PostgresRelay) Send(update Update) error {
+	payload, err := json.Marshal(relayedUpdate{
+		Update:    update,
+		Latitude:  update.Latitude,
+		Longitude: update.Longitude,
+		Private:   update.Private,
+	})
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxNotifyPayload {
+		return ErrUpdateTooLarge
+	}
+	return r.db.Exec("SELECT pg_notify(?, ?)", notifyChannel, string(payload)).Error
+}
+
+// Listen delivers the updates of every replica to the hub until ctx is done. It
+// holds its own connection and reconnects when it is lost; the hub's subscribers
+// are reset then, since updates sent in the meantime were missed.
+func (r *PostgresRelay) Listen(ctx context.Context, hub *Hub) {
+	for {
+		err := r.listen(ctx, hub)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("realtime: relay listener stopped, reconnecting: %v", err)
+		hub.Reset()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+func (r *PostgresRelay) listen(ctx context.Context, hub *Hub) error {
+	conn, err := pgx.Connect(ctx, r.databaseURL)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var relayed relayedUpdate
+		if err := json.Unmarshal([]byte(notification.Payload), &relayed); err != nil {
+			log.Printf("realtime: dropping malformed relayed update: %v", err)
+			continue
+		}
+		update := relayed.Update
+		update.Latitude = relayed.Latitude
+		update.Longitude = relayed.Longitude
+		update.Private = relayed.Private
+		hub.Deliver(update)
+	}
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BlockRepository struct {
+	db *gorm.DB
+}
+
+func NewBlockRepository(db *gorm.DB) *BlockRepository {
+	return &BlockRepository{db: db}
+}
+
+// Create records the block; blocking someone twice is a no-op
+func (r *BlockRepository) Create(block *models.UserBlock) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(block).Error
+}
+
+func (r *BlockRepository) Delete(blockerID, blockedID uuid.UUID) error {
+	return r.db.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Delete(&models.UserBlock{}).Error
+}
+
+// ListByBlocker returns the users the blocker blocked, most recent first
+func (r *BlockRepository) ListByBlocker(blockerID uuid.UUID, offset, limit int) ([]models.UserBlock, int64, error) {
+	var blocks []models.UserBlock
+	var total int64
+
+	query := r.db.Model(&models.UserBlock{}).Where("blocker_id = ?", blockerID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Blocked").Order("created_at DESC").Offset(offset).Limit(limit).Find(&blocks).Error
+	return blocks, total, err
+}
+
+// Between reports whether either user blocked the other
+func (r *BlockRepository) Between(a, b uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", a, b, b, a).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// BlockedByAny reports whether any of the blockers blocked the user
+func (r *BlockRepository) BlockedByAny(blockerIDs []uuid.UUID, blockedID uuid.UUID) (bool, error) {
+	if len(blockerIDs) == 0 {
+		return false, nil
+	}
+	var count int64
+	err := r.db.Model(&models.UserBlock{}).
+		Where("blocker_id IN ? AND blocked_id = ?", blockerIDs, blockedID).
+		Count(&count).Error
+	return count > 0, err
+}
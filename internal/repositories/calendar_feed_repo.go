@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CalendarFeedRepository struct {
+	db *gorm.DB
+}
+
+func NewCalendarFeedRepository(db *gorm.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+// Save creates the user's feed or replaces its token and settings
+func (r *CalendarFeedRepository) Save(feed *models.CalendarFeed) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"token_hash", "include_liked", "created_at", "last_accessed_at"}),
+	}).Create(feed).Error
+}
+
+func (r *CalendarFeedRepository) FindByUser(userID uuid.UUID) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	err := r.db.Where("user_id = ?", userID).First(&feed).Error
+	if err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+func (r *CalendarFeedRepository) FindByTokenHash(tokenHash string) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	err := r.db.Where("token_hash = ?", tokenHash).First(&feed).Error
+	if err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+func (r *CalendarFeedRepository) Touch(userID uuid.UUID, at time.Time) error {
+	return r.db.Model(&models.CalendarFeed{}).Where("user_id = ?", userID).Update("last_accessed_at", at).Error
+}
+
+// Delete removes the user's feed and reports whether there was one
+func (r *CalendarFeedRepository) Delete(userID uuid.UUID) (bool, error) {
+	result := r.db.Where("user_id = ?", userID).Delete(&models.CalendarFeed{})
+	return result.RowsAffected > 0, result.Error
+}
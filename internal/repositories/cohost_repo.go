@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CoHostRepository struct {
+	db *gorm.DB
+}
+
+func NewCoHostRepository(db *gorm.DB) *CoHostRepository {
+	return &CoHostRepository{db: db}
+}
+
+func (r *CoHostRepository) Create(cohost *models.EventCoHost) error {
+	return r.db.Create(cohost).Error
+}
+
+func (r *CoHostRepository) Delete(eventID, userID uuid.UUID) error {
+	return r.db.Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&models.EventCoHost{}).Error
+}
+
+func (r *CoHostRepository) Exists(eventID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.EventCoHost{}).Where("event_id = ? AND user_id = ?", eventID, userID).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *CoHostRepository) ListByEvent(eventID uuid.UUID) ([]models.EventCoHost, error) {
+	var cohosts []models.EventCoHost
+	err := r.db.Preload("User").Where("event_id = ?", eventID).Order("created_at ASC").Find(&cohosts).Error
+	return cohosts, err
+}
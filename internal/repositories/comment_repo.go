@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func (r *CommentRepository) Create(comment *models.EventComment) error {
+	return r.db.Create(comment).Error
+}
+
+func (r *CommentRepository) FindByID(id uuid.UUID) (*models.EventComment, error) {
+	var comment models.EventComment
+	err := r.db.Preload("User").Where("id = ?", id).First(&comment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (r *CommentRepository) Update(comment *models.EventComment) error {
+	return r.db.Omit(clause.Associations).Save(comment).Error
+}
+
+// ListThreads returns a page of top-level comments, pinned first and then newest
+// first, each with its replies in posting order. Hidden comments are left out unless
+// includeHidden is set.
+func (r *CommentRepository) ListThreads(eventID uuid.UUID, includeHidden bool, offset, limit int) ([]models.EventComment, int64, error) {
+	var comments []models.EventComment
+	var total int64
+
+	query := r.db.Model(&models.EventComment{}).Where("event_id = ? AND parent_id IS NULL", eventID)
+	if !includeHidden {
+		query = query.Where("hidden_at IS NULL")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("User").
+		Preload("Replies", func(db *gorm.DB) *gorm.DB {
+			if !includeHidden {
+				db = db.Where("hidden_at IS NULL")
+			}
+			return db.Order("created_at ASC")
+		}).
+		Preload("Replies.User").
+		Order("pinned DESC, pinned_at DESC, created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&comments).Error
+	return comments, total, err
+}
+
+type CommentFilter struct {
+	EventID    *uuid.UUID
+	HiddenOnly bool
+	Offset     int
+	Limit      int
+}
+
+// List returns comments across events for moderation, newest first
+func (r *CommentRepository) List(filter CommentFilter) ([]models.EventComment, int64, error) {
+	var comments []models.EventComment
+	var total int64
+
+	query := r.db.Model(&models.EventComment{})
+	if filter.EventID != nil {
+		query = query.Where("event_id = ?", *filter.EventID)
+	}
+	if filter.HiddenOnly {
+		query = query.Where("hidden_at IS NOT NULL")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("User").Order("created_at DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&comments).Error
+	return comments, total, err
+}
@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CostRepository struct {
+	db *gorm.DB
+}
+
+func NewCostRepository(db *gorm.DB) *CostRepository {
+	return &CostRepository{db: db}
+}
+
+// FindByEvent returns the event's cost split with shares and their users
+func (r *CostRepository) FindByEvent(eventID uuid.UUID) (*models.EventCostSplit, error) {
+	var split models.EventCostSplit
+	err := r.db.Preload("Shares", func(db *gorm.DB) *gorm.DB {
+		return db.Order("amount_cents DESC")
+	}).Preload("Shares.User").Where("event_id = ?", eventID).First(&split).Error
+	if err != nil {
+		return nil, err
+	}
+	return &split, nil
+}
+
+// Replace stores a new split for the event, removing any earlier one and its shares
+func (r *CostRepository) Replace(split *models.EventCostSplit) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ?", split.EventID).Delete(&models.EventCostSplit{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(split).Error
+	})
+}
+
+func (r *CostRepository) DeleteByEvent(eventID uuid.UUID) error {
+	return r.db.Where("event_id = ?", eventID).Delete(&models.EventCostSplit{}).Error
+}
+
+func (r *CostRepository) FindShare(eventID, userID uuid.UUID) (*models.EventCostShare, error) {
+	var share models.EventCostShare
+	err := r.db.Where("event_id = ? AND user_id = ?", eventID, userID).First(&share).Error
+	if err != nil {
+		return nil, err
+	}
+	return &share, nil
+}
+
+func (r *CostRepository) UpdateShare(share *models.EventCostShare) error {
+	return r.db.Omit(clause.Associations).Save(share).Error
+}
+
+// ListOutstandingByUser returns the user's unsettled shares with their events, oldest first
+func (r *CostRepository) ListOutstandingByUser(userID uuid.UUID) ([]models.EventCostShare, error) {
+	var shares []models.EventCostShare
+	err := r.db.Preload("Event").
+		Joins("JOIN events ON events.id = event_cost_shares.event_id").
+		Where("event_cost_shares.user_id = ? AND event_cost_shares.settled_at IS NULL", userID).
+		Where("event_cost_shares.exempt = false AND event_cost_shares.amount_cents > 0").
+		Order("events.event_time ASC").
+		Find(&shares).Error
+	return shares, err
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DeviceRepository struct {
+	db *gorm.DB
+}
+
+func NewDeviceRepository(db *gorm.DB) *DeviceRepository {
+	return &DeviceRepository{db: db}
+}
+
+// Upsert registers the token, moving it to this user if another account had it
+func (r *DeviceRepository) Upsert(device *models.DeviceToken) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "platform", "last_seen_at"}),
+	}).Create(device).Error
+}
+
+func (r *DeviceRepository) FindByToken(token string) (*models.DeviceToken, error) {
+	var device models.DeviceToken
+	err := r.db.Where("token = ?", token).First(&device).Error
+	if err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+func (r *DeviceRepository) ListByUser(userID uuid.UUID) ([]models.DeviceToken, error) {
+	var devices []models.DeviceToken
+	err := r.db.Where("user_id = ?", userID).Order("last_seen_at DESC").Find(&devices).Error
+	return devices, err
+}
+
+func (r *DeviceRepository) ListByUsers(userIDs []uuid.UUID) ([]models.DeviceToken, error) {
+	var devices []models.DeviceToken
+	if len(userIDs) == 0 {
+		return devices, nil
+	}
+	err := r.db.Where("user_id IN ?", userIDs).Find(&devices).Error
+	return devices, err
+}
+
+// Delete removes one of the user's devices and reports whether it existed
+func (r *DeviceRepository) Delete(userID, id uuid.UUID) (bool, error) {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.DeviceToken{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *DeviceRepository) DeleteByToken(token string) error {
+	return r.db.Where("token = ?", token).Delete(&models.DeviceToken{}).Error
+}
@@ -1,12 +1,12 @@
 package repositories
 
 import (
-	"fmt"
 	"playspotter/internal/models"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EventRepository struct {
@@ -17,37 +17,89 @@ func NewEventRepository(db *gorm.DB) *EventRepository {
 	return &EventRepository{db: db}
 }
 
+// WithTx returns a repository whose queries run in the given transaction
+func (r *EventRepository) WithTx(tx *gorm.DB) *EventRepository {
+	return &EventRepository{db: tx}
+}
+
 func (r *EventRepository) Create(event *models.Event) error {
 	return r.db.Create(event).Error
 }
 
 func (r *EventRepository) FindByID(id uuid.UUID) (*models.Event, error) {
 	var event models.Event
-	err := r.db.Preload("Creator").Where("id = ?", id).First(&event).Error
+	err := r.db.Preload("Creator").Preload("Venue").Preload("CoHosts.User").Where("id = ?", id).First(&event).Error
 	if err != nil {
 		return nil, err
 	}
 	return &event, nil
 }
 
+// Update saves the event's own columns; preloaded relations are never written back
 func (r *EventRepository) Update(event *models.Event) error {
-	return r.db.Save(event).Error
+	return r.db.Omit(clause.Associations).Save(event).Error
+}
+
+// TransferOwnership makes newOwnerID the event creator. The new owner stops being a co-host
+// and, when keepPrevious is set, the previous owner becomes one.
+func (r *EventRepository) TransferOwnership(event *models.Event, newOwnerID uuid.UUID, keepPrevious bool) error {
+	previousOwnerID := event.CreatorID
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Event{}).Where("id = ?", event.ID).Update("creator_id", newOwnerID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("event_id = ? AND user_id = ?", event.ID, newOwnerID).Delete(&models.EventCoHost{}).Error; err != nil {
+			return err
+		}
+
+		if keepPrevious {
+			return tx.Create(&models.EventCoHost{
+				EventID: event.ID,
+				UserID:  previousOwnerID,
+				AddedBy: &newOwnerID,
+			}).Error
+		}
+
+		return nil
+	})
 }
 
 func (r *EventRepository) Delete(id uuid.UUID) error {
 	return r.db.Delete(&models.Event{}, id).Error
 }
 
+// DateBound limits the start time of listed events. A Local bound has no offset
+// and is compared with each event's wall-clock start time in its own zone.
+type DateBound struct {
+	Time  time.Time
+	Local bool
+}
+
+// condition returns the SQL comparing the event start with the bound
+func (b *DateBound) condition(op string) (string, interface{}) {
+	if b.Local {
+		return "(e.event_time AT TIME ZONE e.time_zone) " + op + " ?::timestamp", b.Time.Format("2006-01-02 15:04:05.999999")
+	}
+	return "e.event_time " + op + " ?", b.Time
+}
+
 type EventFilter struct {
 	Lat         *float64
 	Lng         *float64
 	MaxDistance *float64
 	SportType   string
-	DateFrom    *time.Time
-	DateTo      *time.Time
+	VenueID     *uuid.UUID
+	DateFrom    *DateBound
+	DateTo      *DateBound
 	Status      string
-	Offset      int
-	Limit       int
+	// FollowedBy limits the feed to events created or joined by people the user follows
+	FollowedBy *uuid.UUID
+	// Viewer hides events created by users the viewer blocked or was blocked by
+	Viewer *uuid.UUID
+	Offset int
+	Limit  int
 }
 
 func (r *EventRepository) List(filter EventFilter) ([]map[string]interface{}, int64, error) {
@@ -55,11 +107,11 @@ func (r *EventRepository) List(filter EventFilter) ([]map[string]interface{}, in
 	var results []map[string]interface{}
 
 	// Base query for counting
-	countQuery := r.db.Model(&models.Event{})
+	countQuery := r.db.Table("events e")
 
 	// Base query for selecting
 	query := r.db.Table("events e").
-		Select("e.*, u.name as creator_name, u.email as creator_email")
+		Select("e.*, u.name as creator_name")
 
 	// Add join for creator
 	query = query.Joins("LEFT JOIN users u ON e.creator_id = u.id")
@@ -67,17 +119,9 @@ func (r *EventRepository) List(filter EventFilter) ([]map[string]interface{}, in
 
 	// Add distance calculation if lat/lng provided
 	if filter.Lat != nil && filter.Lng != nil {
-		distanceFormula := fmt.Sprintf(`
-			2 * 6371 * ASIN(
-				SQRT(
-					POWER(SIN(RADIANS(%f - e.latitude)/2), 2) +
-					COS(RADIANS(e.latitude)) * COS(RADIANS(%f)) *
-					POWER(SIN(RADIANS(%f - e.longitude)/2), 2)
-				)
-			)
-		`, *filter.Lat, *filter.Lat, *filter.Lng)
-
-		query = query.Select("e.*, u.name as creator_name, u.email as creator_email, " + distanceFormula + " as distance_km")
+		distanceFormula := haversineSQL(*filter.Lat, *filter.Lng, "e.latitude", "e.longitude")
+
+		query = query.Select("e.*, u.name as creator_name, " + distanceFormula + " as distance_km")
 
 		// Add max distance filter if provided
 		if filter.MaxDistance != nil {
@@ -89,30 +133,61 @@ func (r *EventRepository) List(filter EventFilter) ([]map[string]interface{}, in
 	// Apply filters
 	if filter.Status != "" {
 		query = query.Where("e.status = ?", filter.Status)
-		countQuery = countQuery.Where("status = ?", filter.Status)
+		countQuery = countQuery.Where("e.status = ?", filter.Status)
 	} else {
 		// Default: only open events
-		query = query.Where("e.status = ?", "open")
-		countQuery = countQuery.Where("status = ?", "open")
+		query = query.Where("e.status = ?", models.EventStatusOpen)
+		countQuery = countQuery.Where("e.status = ?", models.EventStatusOpen)
 	}
 
+	// Drafts are only shown to their organizers
+	query = query.Where("e.status <> ?", models.EventStatusDraft)
+	countQuery = countQuery.Where("e.status <> ?", models.EventStatusDraft)
+
+	// Events hidden by moderators are left out of the feed
+	query = query.Where("e.hidden_at IS NULL")
+	countQuery = countQuery.Where("e.hidden_at IS NULL")
+
 	// Filter future events
 	query = query.Where("e.event_time > ?", time.Now().UTC())
-	countQuery = countQuery.Where("event_time > ?", time.Now().UTC())
+	countQuery = countQuery.Where("e.event_time > ?", time.Now().UTC())
 
 	if filter.SportType != "" {
 		query = query.Where("e.sport_type = ?", filter.SportType)
-		countQuery = countQuery.Where("sport_type = ?", filter.SportType)
+		countQuery = countQuery.Where("e.sport_type = ?", filter.SportType)
+	}
+
+	if filter.VenueID != nil {
+		query = query.Where("e.venue_id = ?", *filter.VenueID)
+		countQuery = countQuery.Where("e.venue_id = ?", *filter.VenueID)
 	}
 
 	if filter.DateFrom != nil {
-		query = query.Where("e.event_time >= ?", filter.DateFrom)
-		countQuery = countQuery.Where("event_time >= ?", filter.DateFrom)
+		condition, value := filter.DateFrom.condition(">=")
+		query = query.Where(condition, value)
+		countQuery = countQuery.Where(condition, value)
 	}
 
 	if filter.DateTo != nil {
-		query = query.Where("e.event_time <= ?", filter.DateTo)
-		countQuery = countQuery.Where("event_time <= ?", filter.DateTo)
+		condition, value := filter.DateTo.condition("<=")
+		query = query.Where(condition, value)
+		countQuery = countQuery.Where(condition, value)
+	}
+
+	if filter.Viewer != nil {
+		blocked := r.db.Model(&models.UserBlock{}).Select("blocked_id").Where("blocker_id = ?", *filter.Viewer)
+		blockedBy := r.db.Model(&models.UserBlock{}).Select("blocker_id").Where("blocked_id = ?", *filter.Viewer)
+		query = query.Where("e.creator_id NOT IN (?) AND e.creator_id NOT IN (?)", blocked, blockedBy)
+		countQuery = countQuery.Where("e.creator_id NOT IN (?) AND e.creator_id NOT IN (?)", blocked, blockedBy)
+	}
+
+	if filter.FollowedBy != nil {
+		followees := r.db.Model(&models.UserFollow{}).Select("followee_id").
+			Where("follower_id = ?", *filter.FollowedBy)
+		joined := r.db.Model(&models.EventParticipant{}).Select("event_id").
+			Where("status = ? AND user_id IN (?)", models.ParticipantStatusJoined, followees)
+		query = query.Where("(e.creator_id IN (?) OR e.id IN (?))", followees, joined)
+		countQuery = countQuery.Where("(e.creator_id IN (?) OR e.id IN (?))", followees, joined)
 	}
 
 	// Count total
@@ -138,20 +213,125 @@ func (r *EventRepository) List(filter EventFilter) ([]map[string]interface{}, in
 	return results, total, nil
 }
 
-func (r *EventRepository) ListAll(offset, limit int) ([]models.Event, int64, error) {
+func (r *EventRepository) ListAll(status string, offset, limit int) ([]models.Event, int64, error) {
+	var events []models.Event
+	var total int64
+
+	query := r.db.Model(&models.Event{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Creator").Offset(offset).Limit(limit).Order("event_time DESC").Find(&events).Error
+	return events, total, err
+}
+
+// ListDrafts returns the unpublished events the user owns or co-hosts
+func (r *EventRepository) ListDrafts(userID uuid.UUID, offset, limit int) ([]models.Event, int64, error) {
 	var events []models.Event
 	var total int64
 
-	if err := r.db.Model(&models.Event{}).Count(&total).Error; err != nil {
+	query := r.db.Model(&models.Event{}).
+		Where("status = ?", models.EventStatusDraft).
+		Where("creator_id = ? OR id IN (?)", userID,
+			r.db.Model(&models.EventCoHost{}).Select("event_id").Where("user_id = ?", userID))
+
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	err := r.db.Preload("Creator").Offset(offset).Limit(limit).Order("event_time DESC").Find(&events).Error
+	err := query.Preload("Venue").Offset(offset).Limit(limit).Order("event_time ASC").Find(&events).Error
 	return events, total, err
 }
 
+// ListUpcomingByCreator returns the user's drafts and published events that have not
+// started yet, up to before when given
+func (r *EventRepository) ListUpcomingByCreator(userID uuid.UUID, before *time.Time) ([]models.Event, error) {
+	var events []models.Event
+
+	query := r.db.Where("creator_id = ? AND event_time > ? AND status IN ?", userID, time.Now().UTC(),
+		[]string{models.EventStatusDraft, models.EventStatusOpen, models.EventStatusFull})
+	if before != nil {
+		query = query.Where("event_time < ?", *before)
+	}
+	err := query.Order("event_time ASC").Find(&events).Error
+	return events, err
+}
+
+// ListForCalendar returns the published events since the given time that the user
+// joined and, with includeLiked, liked. Cancelled events are included so calendar
+// apps can mark them.
+func (r *EventRepository) ListForCalendar(userID uuid.UUID, includeLiked bool, since time.Time) ([]models.Event, error) {
+	var events []models.Event
+
+	joined := r.db.Model(&models.EventParticipant{}).Select("event_id").
+		Where("user_id = ? AND status = ?", userID, models.ParticipantStatusJoined)
+
+	query := r.db.Model(&models.Event{}).
+		Where("status <> ? AND event_time >= ?", models.EventStatusDraft, since)
+	if includeLiked {
+		liked := r.db.Model(&models.EventSwipe{}).Select("event_id").
+			Where("user_id = ? AND action = ?", userID, "like")
+		query = query.Where("id IN (?) OR id IN (?)", joined, liked)
+	} else {
+		query = query.Where("id IN (?)", joined)
+	}
+
+	err := query.Order("event_time ASC").Find(&events).Error
+	return events, err
+}
+
+// AdvanceStatuses moves events along their lifecycle: events that have ended
+// become completed and events that have started become ongoing. It returns the
+// events it changed. Drafts are left alone even once their start time has passed:
+// nobody could join them, and they cannot be published until the organizer moves
+// them to a new time, so they stay drafts for that.
+func (r *EventRepository) AdvanceStatuses(now time.Time) ([]models.Event, error) {
+	var completed []models.Event
+	err := r.db.Raw(`
+		UPDATE events SET status = ?
+		WHERE status IN ?
+			AND event_time + duration_minutes * interval '1 minute' <= ?
+		RETURNING *
+	`, models.EventStatusCompleted,
+		[]string{models.EventStatusOpen, models.EventStatusFull, models.EventStatusOngoing}, now).
+		Scan(&completed).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var started []models.Event
+	err = r.db.Raw(`
+		UPDATE events SET status = ?
+		WHERE status IN ? AND event_time <= ?
+		RETURNING *
+	`, models.EventStatusOngoing,
+		[]string{models.EventStatusOpen, models.EventStatusFull}, now).
+		Scan(&started).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return append(completed, started...), nil
+}
+
 func (r *EventRepository) GetParticipantCount(eventID uuid.UUID) (int64, error) {
 	var count int64
-	err := r.db.Model(&models.EventParticipant{}).Where("event_id = ?", eventID).Count(&count).Error
+	err := r.db.Model(&models.EventParticipant{}).
+		Where("event_id = ? AND status = ?", eventID, models.ParticipantStatusJoined).
+		Count(&count).Error
+	return count, err
+}
+
+// CountHosted counts the completed events the user created
+func (r *EventRepository) CountHosted(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Event{}).
+		Where("creator_id = ? AND status = ?", userID, models.EventStatusCompleted).
+		Count(&count).Error
 	return count, err
 }
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FollowRepository struct {
+	db *gorm.DB
+}
+
+func NewFollowRepository(db *gorm.DB) *FollowRepository {
+	return &FollowRepository{db: db}
+}
+
+// Create records the follow; it reports false when the user already followed
+func (r *FollowRepository) Create(follow *models.UserFollow) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(follow)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *FollowRepository) Delete(followerID, followeeID uuid.UUID) error {
+	return r.db.Where("follower_id = ? AND followee_id = ?", followerID, followeeID).Delete(&models.UserFollow{}).Error
+}
+
+func (r *FollowRepository) Exists(followerID, followeeID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.UserFollow{}).
+		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *FollowRepository) CountFollowers(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.UserFollow{}).Where("followee_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *FollowRepository) CountFollowing(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.UserFollow{}).Where("follower_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+// ListFollowers returns who follows the user, most recent first
+func (r *FollowRepository) ListFollowers(userID uuid.UUID, offset, limit int) ([]models.UserFollow, int64, error) {
+	var follows []models.UserFollow
+	total, err := r.CountFollowers(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.db.Preload("Follower").Where("followee_id = ?", userID).
+		Order("created_at DESC").Offset(offset).Limit(limit).Find(&follows).Error
+	return follows, total, err
+}
+
+// ListFollowing returns who the user follows, most recent first
+func (r *FollowRepository) ListFollowing(userID uuid.UUID, offset, limit int) ([]models.UserFollow, int64, error) {
+	var follows []models.UserFollow
+	total, err := r.CountFollowing(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.db.Preload("Followee").Where("follower_id = ?", userID).
+		Order("created_at DESC").Offset(offset).Limit(limit).Find(&follows).Error
+	return follows, total, err
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import "fmt"
+
+// haversineSQL returns a SQL expression for the great-circle distance in km
+// between the given point and the row's latitude/longitude columns
+func haversineSQL(lat, lng float64, latColumn, lngColumn string) string {
+	return fmt.Sprintf(`
+			2 * 6371 * ASIN(
+				SQRT(
+					POWER(SIN(RADIANS(%f - %s)/2), 2) +
+					COS(RADIANS(%s)) * COS(RADIANS(%f)) *
+					POWER(SIN(RADIANS(%f - %s)/2), 2)
+				)
+			)
+		`, lat, latColumn, latColumn, lat, lng, lngColumn)
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JobRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) *JobRepository {
+	return &JobRepository{db: db}
+}
+
+// WithTx returns a repository whose queries run in the given transaction
+func (r *JobRepository) WithTx(tx *gorm.DB) *JobRepository {
+	return &JobRepository{db: tx}
+}
+
+// Enqueue stores the job; a job whose UniqueKey is already queued is skipped
+func (r *JobRepository) Enqueue(job *models.Job) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "unique_key"}},
+		DoNothing: true,
+	}).Create(job).Error
+}
+
+// Lease claims up to limit due jobs for the worker. Jobs whose earlier lease
+// expired are claimed again while they have attempts left; SKIP LOCKED keeps
+// concurrent workers from taking the same job.
+func (r *JobRepository) Lease(workerID string, limit int, lease time.Duration) ([]models.Job, error) {
+	var jobs []models.Job
+	err := r.db.Raw(`
+		UPDATE jobs
+		SET status = ?, locked_by = ?, locked_until = now() + ? * interval '1 second', attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM jobs
+			WHERE (status = ? AND run_at <= now())
+				OR (status = ? AND locked_until < now() AND attempts < max_attempts)
+			ORDER BY run_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`, models.JobStatusRunning, workerID, lease.Seconds(),
+		models.JobStatusPending, models.JobStatusRunning, limit).Scan(&jobs).Error
+	return jobs, err
+}
+
+// Complete marks the job done if the worker still holds its lease
+func (r *JobRepository) Complete(id uuid.UUID, workerID string) error {
+	return r.db.Model(&models.Job{}).
+		Where("id = ? AND locked_by = ? AND status = ?", id, workerID, models.JobStatusRunning).
+		Updates(map[string]interface{}{
+			"status":       models.JobStatusDone,
+			"locked_by":    nil,
+			"locked_until": nil,
+			"completed_at": time.Now().UTC(),
+		}).Error
+}
+
+// Fail records the error and queues the job again at retryAt, or marks it failed
+// when retryAt is nil
+func (r *JobRepository) Fail(id uuid.UUID, workerID, message string, retryAt *time.Time) error {
+	updates := map[string]interface{}{
+		"status":       models.JobStatusFailed,
+		"locked_by":    nil,
+		"locked_until": nil,
+		"last_error":   message,
+	}
+	if retryAt != nil {
+		updates["status"] = models.JobStatusPending
+		updates["run_at"] = *retryAt
+	}
+
+	return r.db.Model(&models.Job{}).
+		Where("id = ? AND locked_by = ? AND status = ?", id, workerID, models.JobStatusRunning).
+		Updates(updates).Error
+}
+
+// FailExpired marks running jobs failed when their lease expired on the last attempt,
+// so a job that keeps killing its worker is not retried forever
+func (r *JobRepository) FailExpired() (int64, error) {
+	result := r.db.Model(&models.Job{}).
+		Where("status = ? AND locked_until < now() AND attempts >= max_attempts", models.JobStatusRunning).
+		Updates(map[string]interface{}{
+			"status":       models.JobStatusFailed,
+			"locked_by":    nil,
+			"locked_until": nil,
+			"last_error":   "lease expired on the last attempt",
+		})
+	return result.RowsAffected, result.Error
+}
+
+// PurgeDone deletes jobs that completed before the given time. Failed jobs are kept
+// for inspection.
+func (r *JobRepository) PurgeDone(before time.Time) (int64, error) {
+	result := r.db.Where("status = ? AND completed_at < ?", models.JobStatusDone, before).
+		Delete(&models.Job{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ModerationRepository stores moderation actions. There is no update or delete:
+// the record of who did what is append-only.
+type ModerationRepository struct {
+	db *gorm.DB
+}
+
+func NewModerationRepository(db *gorm.DB) *ModerationRepository {
+	return &ModerationRepository{db: db}
+}
+
+func (r *ModerationRepository) Create(action *models.ModerationAction) error {
+	return r.db.Create(action).Error
+}
+
+type ModerationActionFilter struct {
+	ReportID   *uuid.UUID
+	AdminID    *uuid.UUID
+	UserID     *uuid.UUID
+	TargetType string
+	TargetID   *uuid.UUID
+	Action     string
+	Offset     int
+	Limit      int
+}
+
+// List returns moderation actions, newest first
+func (r *ModerationRepository) List(filter ModerationActionFilter) ([]models.ModerationAction, int64, error) {
+	var actions []models.ModerationAction
+	var total int64
+
+	query := r.db.Model(&models.ModerationAction{})
+	if filter.ReportID != nil {
+		query = query.Where("report_id = ?", *filter.ReportID)
+	}
+	if filter.AdminID != nil {
+		query = query.Where("admin_id = ?", *filter.AdminID)
+	}
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != nil {
+		query = query.Where("target_id = ?", *filter.TargetID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Preload("Admin").Order("created_at DESC")
+	if filter.Limit > 0 {
+		query = query.Offset(filter.Offset).Limit(filter.Limit)
+	}
+	err := query.Find(&actions).Error
+	return actions, total, err
+}
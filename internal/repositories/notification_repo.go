@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) CreateBatch(notifications []models.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	return r.db.Create(&notifications).Error
+}
+
+// ListByUser returns a page of the user's notifications, newest first
+func (r *NotificationRepository) ListByUser(userID uuid.UUID, unreadOnly bool, offset, limit int) ([]models.Notification, int64, error) {
+	var notifications []models.Notification
+	var total int64
+
+	query := r.db.Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&notifications).Error
+	return notifications, total, err
+}
+
+func (r *NotificationRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkRead marks the given notifications of the user as read; with no IDs it marks all of them
+func (r *NotificationRepository) MarkRead(userID uuid.UUID, ids []uuid.UUID) (int64, error) {
+	query := r.db.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", userID)
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	result := query.Update("read_at", time.Now().UTC())
+	return result.RowsAffected, result.Error
+}
+
+func (r *NotificationRepository) ListPreferences(userID uuid.UUID) ([]models.NotificationPreference, error) {
+	var prefs []models.NotificationPreference
+	err := r.db.Where("user_id = ?", userID).Find(&prefs).Error
+	return prefs, err
+}
+
+func (r *NotificationRepository) SavePreferences(prefs []models.NotificationPreference) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"in_app", "push", "updated_at"}),
+	}).Create(&prefs).Error
+}
+
+// PreferencesFor returns the stored preferences of the users for one notification type
+func (r *NotificationRepository) PreferencesFor(notificationType string, userIDs []uuid.UUID) (map[uuid.UUID]models.NotificationPreference, error) {
+	prefs := make(map[uuid.UUID]models.NotificationPreference)
+	if len(userIDs) == 0 {
+		return prefs, nil
+	}
+
+	var rows []models.NotificationPreference
+	err := r.db.Where("type = ? AND user_id IN ?", notificationType, userIDs).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pref := range rows {
+		prefs[pref.UserID] = pref
+	}
+	return prefs, nil
+}
@@ -2,9 +2,11 @@ package repositories
 
 import (
 	"playspotter/internal/models"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ParticipantRepository struct {
@@ -15,22 +17,71 @@ func NewParticipantRepository(db *gorm.DB) *ParticipantRepository {
 	return &ParticipantRepository{db: db}
 }
 
+// WithTx returns a repository whose queries run in the given transaction
+func (r *ParticipantRepository) WithTx(tx *gorm.DB) *ParticipantRepository {
+	return &ParticipantRepository{db: tx}
+}
+
 func (r *ParticipantRepository) Create(participant *models.EventParticipant) error {
 	return r.db.Create(participant).Error
 }
 
+func (r *ParticipantRepository) Update(participant *models.EventParticipant) error {
+	return r.db.Omit(clause.Associations).Save(participant).Error
+}
+
 func (r *ParticipantRepository) Delete(eventID, userID uuid.UUID) error {
 	return r.db.Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&models.EventParticipant{}).Error
 }
 
+// Find returns the user's participation record for the event in any status
+func (r *ParticipantRepository) Find(eventID, userID uuid.UUID) (*models.EventParticipant, error) {
+	var participant models.EventParticipant
+	err := r.db.Where("event_id = ? AND user_id = ?", eventID, userID).First(&participant).Error
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+// Exists reports whether the user is an active participant of the event
 func (r *ParticipantRepository) Exists(eventID, userID uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", eventID, userID).Count(&count).Error
+	err := r.db.Model(&models.EventParticipant{}).
+		Where("event_id = ? AND user_id = ? AND status = ?", eventID, userID, models.ParticipantStatusJoined).
+		Count(&count).Error
 	return count > 0, err
 }
 
+// CountByEvent counts the spots taken: active participants plus unexpired payment reservations
 func (r *ParticipantRepository) CountByEvent(eventID uuid.UUID) (int64, error) {
 	var count int64
-	err := r.db.Model(&models.EventParticipant{}).Where("event_id = ?", eventID).Count(&count).Error
+	err := r.db.Model(&models.EventParticipant{}).
+		Where("event_id = ?", eventID).
+		Where("status = ? OR (status = ? AND payment_expires_at > ?)",
+			models.ParticipantStatusJoined, models.ParticipantStatusPendingPayment, time.Now().UTC()).
+		Count(&count).Error
+	return count, err
+}
+
+// ListByEvent returns the roster in join order; statuses filters it when given
+func (r *ParticipantRepository) ListByEvent(eventID uuid.UUID, statuses ...string) ([]models.EventParticipant, error) {
+	var participants []models.EventParticipant
+	query := r.db.Preload("User").Where("event_id = ?", eventID)
+	if len(statuses) > 0 {
+		query = query.Where("status IN ?", statuses)
+	}
+	err := query.Order("joined_at ASC").Find(&participants).Error
+	return participants, err
+}
+
+// CountAttended counts the completed events the user took part in
+func (r *ParticipantRepository) CountAttended(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.EventParticipant{}).
+		Joins("JOIN events e ON e.id = event_participants.event_id").
+		Where("event_participants.user_id = ? AND event_participants.status = ? AND e.status = ?",
+			userID, models.ParticipantStatusJoined, models.EventStatusCompleted).
+		Count(&count).Error
 	return count, err
 }
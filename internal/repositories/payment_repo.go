@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaymentRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+// WithTx returns a repository whose queries run in the given transaction
+func (r *PaymentRepository) WithTx(tx *gorm.DB) *PaymentRepository {
+	return &PaymentRepository{db: tx}
+}
+
+func (r *PaymentRepository) Create(payment *models.Payment) error {
+	return r.db.Create(payment).Error
+}
+
+func (r *PaymentRepository) Update(payment *models.Payment) error {
+	return r.db.Save(payment).Error
+}
+
+func (r *PaymentRepository) FindByID(id uuid.UUID) (*models.Payment, error) {
+	var payment models.Payment
+	err := r.db.Where("id = ?", id).First(&payment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// FindByProviderRef looks up a payment by the provider's reference; inside a transaction
+// the row stays locked until it ends
+func (r *PaymentRepository) FindByProviderRef(provider, providerRef string) (*models.Payment, error) {
+	var payment models.Payment
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("provider = ? AND provider_ref = ?", provider, providerRef).First(&payment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// FindActive returns the user's unexpired pending payment for the event
+func (r *PaymentRepository) FindActive(eventID, userID uuid.UUID) (*models.Payment, error) {
+	var payment models.Payment
+	err := r.db.Where("event_id = ? AND user_id = ? AND status = ? AND expires_at > ?",
+		eventID, userID, models.PaymentStatusPending, time.Now().UTC()).
+		Order("created_at DESC").
+		First(&payment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// ExpireStale marks the user's timed-out pending payments for the event as expired
+func (r *PaymentRepository) ExpireStale(eventID, userID uuid.UUID) error {
+	return r.db.Model(&models.Payment{}).
+		Where("event_id = ? AND user_id = ? AND status = ? AND expires_at <= ?",
+			eventID, userID, models.PaymentStatusPending, time.Now().UTC()).
+		Update("status", models.PaymentStatusExpired).Error
+}
+
+// ExpireAllPending expires every pending payment for the event
+func (r *PaymentRepository) ExpireAllPending(eventID uuid.UUID) error {
+	return r.db.Model(&models.Payment{}).
+		Where("event_id = ? AND status = ?", eventID, models.PaymentStatusPending).
+		Update("status", models.PaymentStatusExpired).Error
+}
+
+func (r *PaymentRepository) ListByEvent(eventID uuid.UUID, statuses ...string) ([]models.Payment, error) {
+	var payments []models.Payment
+	query := r.db.Where("event_id = ?", eventID)
+	if len(statuses) > 0 {
+		query = query.Where("status IN ?", statuses)
+	}
+	err := query.Order("created_at ASC").Find(&payments).Error
+	return payments, err
+}
+
+// ListByUser returns the user's payments for the event with any of the given statuses
+func (r *PaymentRepository) ListByUser(eventID, userID uuid.UUID, statuses ...string) ([]models.Payment, error) {
+	var payments []models.Payment
+	err := r.db.Where("event_id = ? AND user_id = ? AND status IN ?", eventID, userID, statuses).
+		Order("created_at ASC").
+		Find(&payments).Error
+	return payments, err
+}
+
+// ProcessWebhook records a provider callback and runs apply in the same transaction.
+// The unique (provider, external_id) key makes concurrent deliveries of one callback
+// wait for each other; a callback that is already recorded is skipped. When apply
+// fails the record is rolled back, so the provider's retry is handled again.
+func (r *PaymentRepository) ProcessWebhook(event *models.PaymentWebhookEvent, apply func(tx *gorm.DB) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return apply(tx)
+	})
+}
@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+func (r *ReportRepository) Create(report *models.Report) error {
+	return r.db.Create(report).Error
+}
+
+func (r *ReportRepository) FindByID(id uuid.UUID) (*models.Report, error) {
+	var report models.Report
+	err := r.db.Preload("Reporter").Where("id = ?", id).First(&report).Error
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (r *ReportRepository) Update(report *models.Report) error {
+	return r.db.Omit(clause.Associations).Save(report).Error
+}
+
+// FindOpen returns the reporter's report about the target that is still waiting for review
+func (r *ReportRepository) FindOpen(reporterID uuid.UUID, targetType string, targetID uuid.UUID) (*models.Report, error) {
+	var report models.Report
+	err := r.db.Where("reporter_id = ? AND target_type = ? AND target_id = ? AND status IN ?",
+		reporterID, targetType, targetID, []string{models.ReportStatusOpen, models.ReportStatusClaimed}).
+		First(&report).Error
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+type ReportFilter struct {
+	Status     string
+	ClaimedBy  *uuid.UUID
+	TargetType string
+	Category   string
+	Offset     int
+	Limit      int
+}
+
+// List returns reports oldest first, so the queue is worked in order
+func (r *ReportRepository) List(filter ReportFilter) ([]models.Report, int64, error) {
+	var reports []models.Report
+	var total int64
+
+	query := r.db.Model(&models.Report{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.ClaimedBy != nil {
+		query = query.Where("claimed_by = ?", *filter.ClaimedBy)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.Category != "" {
+		query = query.Where("category = ?", filter.Category)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Reporter").Order("created_at ASC").Offset(filter.Offset).Limit(filter.Limit).Find(&reports).Error
+	return reports, total, err
+}
+
+// Claim gives an open report to the admin; it reports false when the report was
+// not open anymore
+func (r *ReportRepository) Claim(id, adminID uuid.UUID, at time.Time) (bool, error) {
+	result := r.db.Model(&models.Report{}).
+		Where("id = ? AND status = ?", id, models.ReportStatusOpen).
+		Updates(map[string]interface{}{
+			"status":     models.ReportStatusClaimed,
+			"claimed_by": adminID,
+			"claimed_at": at,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Release puts a report the admin holds back in the queue; it reports false when
+// the admin did not hold it
+func (r *ReportRepository) Release(id, adminID uuid.UUID) (bool, error) {
+	result := r.db.Model(&models.Report{}).
+		Where("id = ? AND status = ? AND claimed_by = ?", id, models.ReportStatusClaimed, adminID).
+		Updates(map[string]interface{}{
+			"status":     models.ReportStatusOpen,
+			"claimed_by": nil,
+			"claimed_at": nil,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Resolve closes a report that is open or held by the admin; it reports false when
+// the report was closed or claimed by someone else in the meantime
+func (r *ReportRepository) Resolve(id, adminID uuid.UUID, status, resolution string, at time.Time) (bool, error) {
+	result := r.db.Model(&models.Report{}).
+		Where("id = ? AND (status = ? OR (status = ? AND claimed_by = ?))",
+			id, models.ReportStatusOpen, models.ReportStatusClaimed, adminID).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"resolved_by": adminID,
+			"resolved_at": at,
+			"resolution":  resolution,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ResolveOpenForTarget closes every report about the target that is still waiting
+// for review with the given resolution
+func (r *ReportRepository) ResolveOpenForTarget(targetType string, targetID, adminID uuid.UUID, resolution string, at time.Time) error {
+	return r.db.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND status IN ?",
+			targetType, targetID, []string{models.ReportStatusOpen, models.ReportStatusClaimed}).
+		Updates(map[string]interface{}{
+			"status":      models.ReportStatusResolved,
+			"resolved_by": adminID,
+			"resolved_at": at,
+			"resolution":  resolution,
+		}).Error
+}
+
+// CountByTarget counts all reports about the target, to show how often it was reported
+func (r *ReportRepository) CountByTarget(targetType string, targetID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Report{}).Where("target_type = ? AND target_id = ?", targetType, targetID).Count(&count).Error
+	return count, err
+}
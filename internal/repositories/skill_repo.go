@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SkillRepository struct {
+	db *gorm.DB
+}
+
+func NewSkillRepository(db *gorm.DB) *SkillRepository {
+	return &SkillRepository{db: db}
+}
+
+// Upsert stores the user's level for a sport, replacing any earlier value
+func (r *SkillRepository) Upsert(skill *models.UserSportSkill) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "sport_type"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"level": skill.Level, "updated_at": gorm.Expr("now()")}),
+	}).Create(skill).Error
+}
+
+func (r *SkillRepository) Delete(userID uuid.UUID, sportType string) error {
+	return r.db.Where("user_id = ? AND sport_type = ?", userID, sportType).Delete(&models.UserSportSkill{}).Error
+}
+
+func (r *SkillRepository) ListByUser(userID uuid.UUID) ([]models.UserSportSkill, error) {
+	var skills []models.UserSportSkill
+	err := r.db.Where("user_id = ?", userID).Order("sport_type ASC").Find(&skills).Error
+	return skills, err
+}
+
+// LevelsForUsers maps each user with a recorded level for the sport to that level
+func (r *SkillRepository) LevelsForUsers(sportType string, userIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	var skills []models.UserSportSkill
+	levels := make(map[uuid.UUID]int)
+	if len(userIDs) == 0 {
+		return levels, nil
+	}
+
+	err := r.db.Where("sport_type = ? AND user_id IN ?", sportType, userIDs).Find(&skills).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, skill := range skills {
+		levels[skill.UserID] = skill.Level
+	}
+	return levels, nil
+}
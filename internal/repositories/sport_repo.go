@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SportRepository struct {
+	db *gorm.DB
+}
+
+func NewSportRepository(db *gorm.DB) *SportRepository {
+	return &SportRepository{db: db}
+}
+
+func (r *SportRepository) Create(sport *models.Sport) error {
+	return r.db.Create(sport).Error
+}
+
+func (r *SportRepository) FindByID(id uuid.UUID) (*models.Sport, error) {
+	var sport models.Sport
+	err := r.db.Where("id = ?", id).First(&sport).Error
+	if err != nil {
+		return nil, err
+	}
+	return &sport, nil
+}
+
+func (r *SportRepository) FindBySlug(slug string) (*models.Sport, error) {
+	var sport models.Sport
+	err := r.db.Where("slug = ?", slug).First(&sport).Error
+	if err != nil {
+		return nil, err
+	}
+	return &sport, nil
+}
+
+func (r *SportRepository) Update(sport *models.Sport) error {
+	return r.db.Save(sport).Error
+}
+
+// List returns the catalog ordered by slug; inactive sports are included only when requested
+func (r *SportRepository) List(includeInactive bool) ([]models.Sport, error) {
+	var sports []models.Sport
+	query := r.db.Model(&models.Sport{})
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+	err := query.Order("slug ASC").Find(&sports).Error
+	return sports, err
+}
+
+func (r *SportRepository) CountEvents(slug string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Event{}).Where("sport_type = ?", slug).Count(&count).Error
+	return count, err
+}
+
+func (r *SportRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Sport{}, id).Error
+}
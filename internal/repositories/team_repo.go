@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TeamRepository struct {
+	db *gorm.DB
+}
+
+func NewTeamRepository(db *gorm.DB) *TeamRepository {
+	return &TeamRepository{db: db}
+}
+
+// ReplaceForEvent swaps the event's teams for the given ones in a single transaction
+func (r *TeamRepository) ReplaceForEvent(eventID uuid.UUID, teams []models.EventTeam) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ?", eventID).Delete(&models.EventTeam{}).Error; err != nil {
+			return err
+		}
+		if len(teams) == 0 {
+			return nil
+		}
+		return tx.Create(&teams).Error
+	})
+}
+
+func (r *TeamRepository) ListByEvent(eventID uuid.UUID) ([]models.EventTeam, error) {
+	var teams []models.EventTeam
+	// Members who were removed or banned after the split drop out of their team
+	err := r.db.
+		Preload("Members", `EXISTS (
+			SELECT 1 FROM event_participants p
+			WHERE p.event_id = event_team_members.event_id
+			  AND p.user_id = event_team_members.user_id
+			  AND p.status = ?
+		)`, models.ParticipantStatusJoined).
+		Preload("Members.User").
+		Where("event_id = ?", eventID).
+		Order("position ASC").
+		Find(&teams).Error
+	return teams, err
+}
+
+func (r *TeamRepository) DeleteByEvent(eventID uuid.UUID) error {
+	return r.db.Where("event_id = ?", eventID).Delete(&models.EventTeam{}).Error
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewTemplateRepository(db *gorm.DB) *TemplateRepository {
+	return &TemplateRepository{db: db}
+}
+
+func (r *TemplateRepository) Create(template *models.EventTemplate) error {
+	return r.db.Create(template).Error
+}
+
+func (r *TemplateRepository) FindByID(id uuid.UUID) (*models.EventTemplate, error) {
+	var template models.EventTemplate
+	err := r.db.Preload("Venue").Where("id = ?", id).First(&template).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *TemplateRepository) ListByUser(userID uuid.UUID) ([]models.EventTemplate, error) {
+	var templates []models.EventTemplate
+	err := r.db.Preload("Venue").Where("user_id = ?", userID).Order("name ASC").Find(&templates).Error
+	return templates, err
+}
+
+func (r *TemplateRepository) Update(template *models.EventTemplate) error {
+	return r.db.Omit(clause.Associations).Save(template).Error
+}
+
+func (r *TemplateRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.EventTemplate{}, id).Error
+}
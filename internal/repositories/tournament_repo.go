@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+	"sort"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TournamentRepository struct {
+	db *gorm.DB
+}
+
+func NewTournamentRepository(db *gorm.DB) *TournamentRepository {
+	return &TournamentRepository{db: db}
+}
+
+func (r *TournamentRepository) Create(tournament *models.Tournament) error {
+	return r.db.Create(tournament).Error
+}
+
+func (r *TournamentRepository) FindByID(id uuid.UUID) (*models.Tournament, error) {
+	var tournament models.Tournament
+	err := r.db.Where("id = ?", id).First(&tournament).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tournament, nil
+}
+
+func (r *TournamentRepository) FindByEventID(eventID uuid.UUID) (*models.Tournament, error) {
+	var tournament models.Tournament
+	err := r.db.Where("event_id = ?", eventID).First(&tournament).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tournament, nil
+}
+
+func (r *TournamentRepository) Update(tournament *models.Tournament) error {
+	return r.db.Omit(clause.Associations).Save(tournament).Error
+}
+
+func (r *TournamentRepository) CreateTeam(team *models.TournamentTeam) error {
+	return r.db.Create(team).Error
+}
+
+func (r *TournamentRepository) FindTeam(tournamentID, teamID uuid.UUID) (*models.TournamentTeam, error) {
+	var team models.TournamentTeam
+	err := r.db.Preload("Members").Where("tournament_id = ? AND id = ?", tournamentID, teamID).First(&team).Error
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (r *TournamentRepository) DeleteTeam(teamID uuid.UUID) error {
+	return r.db.Delete(&models.TournamentTeam{}, teamID).Error
+}
+
+// ListTeams returns registered teams in seed order
+func (r *TournamentRepository) ListTeams(tournamentID uuid.UUID) ([]models.TournamentTeam, error) {
+	var teams []models.TournamentTeam
+	err := r.db.Preload("Members").Where("tournament_id = ?", tournamentID).Order("seed ASC, created_at ASC").Find(&teams).Error
+	return teams, err
+}
+
+// MembersOnOtherTeams returns the users among userIDs already registered in the tournament
+func (r *TournamentRepository) MembersOnOtherTeams(tournamentID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var taken []uuid.UUID
+	err := r.db.Model(&models.TournamentTeamMember{}).
+		Where("tournament_id = ? AND user_id IN ?", tournamentID, userIDs).
+		Pluck("user_id", &taken).Error
+	return taken, err
+}
+
+// StartWithMatches stores the generated fixtures and moves the tournament into play.
+// Later rounds are inserted first so next_match_id always points at an existing row.
+func (r *TournamentRepository) StartWithMatches(tournament *models.Tournament, matches []models.TournamentMatch) error {
+	sorted := make([]models.TournamentMatch, len(matches))
+	copy(sorted, matches)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Round > sorted[j].Round })
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tournament_id = ?", tournament.ID).Delete(&models.TournamentMatch{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&sorted).Error; err != nil {
+			return err
+		}
+		return tx.Omit(clause.Associations).Save(tournament).Error
+	})
+}
+
+func (r *TournamentRepository) FindMatch(tournamentID, matchID uuid.UUID) (*models.TournamentMatch, error) {
+	var match models.TournamentMatch
+	err := r.db.Where("tournament_id = ? AND id = ?", tournamentID, matchID).First(&match).Error
+	if err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+func (r *TournamentRepository) FindMatchByID(matchID uuid.UUID) (*models.TournamentMatch, error) {
+	var match models.TournamentMatch
+	err := r.db.Where("id = ?", matchID).First(&match).Error
+	if err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+func (r *TournamentRepository) ListMatches(tournamentID uuid.UUID) ([]models.TournamentMatch, error) {
+	var matches []models.TournamentMatch
+	err := r.db.Where("tournament_id = ?", tournamentID).Order("round ASC, position ASC").Find(&matches).Error
+	return matches, err
+}
+
+// SaveResult stores a match result together with the knock-on changes (advanced
+// next match, finished tournament) in one transaction
+func (r *TournamentRepository) SaveResult(match *models.TournamentMatch, next *models.TournamentMatch, tournament *models.Tournament) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(match).Error; err != nil {
+			return err
+		}
+		if next != nil {
+			if err := tx.Save(next).Error; err != nil {
+				return err
+			}
+		}
+		if tournament != nil {
+			return tx.Omit(clause.Associations).Save(tournament).Error
+		}
+		return nil
+	})
+}
+
+func (r *TournamentRepository) UpdateMatch(match *models.TournamentMatch) error {
+	return r.db.Save(match).Error
+}
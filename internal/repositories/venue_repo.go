@@ -0,0 +1,155 @@
+package repositories
+
+import (
+	"playspotter/internal/models"
+	"playspotter/internal/timezone"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type VenueRepository struct {
+	db *gorm.DB
+}
+
+func NewVenueRepository(db *gorm.DB) *VenueRepository {
+	return &VenueRepository{db: db}
+}
+
+func (r *VenueRepository) Create(venue *models.Venue) error {
+	return r.db.Create(venue).Error
+}
+
+func (r *VenueRepository) FindByID(id uuid.UUID) (*models.Venue, error) {
+	var venue models.Venue
+	err := r.db.Where("id = ?", id).First(&venue).Error
+	if err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+func (r *VenueRepository) Update(venue *models.Venue) error {
+	return r.db.Save(venue).Error
+}
+
+type VenueFilter struct {
+	Lat         *float64
+	Lng         *float64
+	MaxDistance *float64
+	SportType   string
+	Query       string
+	Offset      int
+	Limit       int
+}
+
+// Search lists venues, nearest first when a point is given
+func (r *VenueRepository) Search(filter VenueFilter) ([]models.VenueWithDistance, int64, error) {
+	var total int64
+	var results []models.VenueWithDistance
+
+	query := r.db.Table("venues v").Select("v.*")
+	countQuery := r.db.Table("venues v")
+
+	if filter.Lat != nil && filter.Lng != nil {
+		distanceFormula := haversineSQL(*filter.Lat, *filter.Lng, "v.latitude", "v.longitude")
+		query = query.Select("v.*, " + distanceFormula + " as distance_km")
+
+		if filter.MaxDistance != nil {
+			query = query.Where(distanceFormula+" <= ?", *filter.MaxDistance)
+			countQuery = countQuery.Where(distanceFormula+" <= ?", *filter.MaxDistance)
+		}
+	}
+
+	if filter.SportType != "" {
+		// Venues without a sports list are treated as multi-purpose
+		sportCond := "(v.sports = '[]'::jsonb OR v.sports @> jsonb_build_array(?::text))"
+		query = query.Where(sportCond, filter.SportType)
+		countQuery = countQuery.Where(sportCond, filter.SportType)
+	}
+
+	if filter.Query != "" {
+		like := "%" + filter.Query + "%"
+		query = query.Where("(v.name ILIKE ? OR v.address ILIKE ?)", like, like)
+		countQuery = countQuery.Where("(v.name ILIKE ? OR v.address ILIKE ?)", like, like)
+	}
+
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filter.Lat != nil && filter.Lng != nil {
+		query = query.Order("distance_km ASC, v.name ASC")
+	} else {
+		query = query.Order("v.name ASC")
+	}
+
+	if err := query.Offset(filter.Offset).Limit(filter.Limit).Find(&results).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return results, total, nil
+}
+
+// SyncUpcomingEvents copies the venue's location onto its future events so the
+// event feed and distance filters keep matching the venue
+func (r *VenueRepository) SyncUpcomingEvents(venue *models.Venue) error {
+	return r.db.Model(&models.Event{}).
+		Where("venue_id = ? AND event_time > now()", venue.ID).
+		Updates(venueLocation(venue)).Error
+}
+
+// HasUpcomingEventsByOthers reports whether an organizer other than the user has a
+// future, not cancelled event at the venue
+func (r *VenueRepository) HasUpcomingEventsByOthers(venueID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Event{}).
+		Where("venue_id = ? AND event_time > now() AND creator_id <> ? AND status <> ?",
+			venueID, userID, models.EventStatusCancelled).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// venueLocation is the event columns that follow the venue. Events that move take
+// the time zone of the new coordinates, as when an organizer moves them; events
+// that stay put keep theirs, which may have been chosen explicitly.
+func venueLocation(venue *models.Venue) map[string]interface{} {
+	return map[string]interface{}{
+		"location_name": venue.Name,
+		"address":       venue.Address,
+		"latitude":      venue.Latitude,
+		"longitude":     venue.Longitude,
+		"time_zone": gorm.Expr("CASE WHEN latitude <> ? OR longitude <> ? THEN ? ELSE time_zone END",
+			venue.Latitude, venue.Longitude, timezone.ForCoordinates(venue.Latitude, venue.Longitude)),
+	}
+}
+
+// Merge moves every event from the duplicate venue onto the target and deletes the
+// duplicate; like SyncUpcomingEvents it only rewrites the location of future events
+func (r *VenueRepository) Merge(target *models.Venue, duplicateID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(target).Error; err != nil {
+			return err
+		}
+
+		// Upcoming events take the target's location; events that already happened
+		// keep the location they were held at
+		location := venueLocation(target)
+		location["venue_id"] = target.ID
+		err := tx.Model(&models.Event{}).
+			Where("venue_id = ? AND event_time > now()", duplicateID).
+			Updates(location).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Event{}).
+			Where("venue_id = ?", duplicateID).
+			Update("venue_id", target.ID).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(&models.Venue{}, duplicateID).Error
+	})
+}
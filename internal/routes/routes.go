@@ -10,12 +10,28 @@ import (
 )
 
 type Router struct {
-	authHandler  *handlers.AuthHandler
-	meHandler    *handlers.MeHandler
-	eventHandler *handlers.EventHandler
-	adminHandler *handlers.AdminHandler
-	jwtManager   *jwt.Manager
-	cfg          *config.Config
+	authHandler         *handlers.AuthHandler
+	meHandler           *handlers.MeHandler
+	eventHandler        *handlers.EventHandler
+	adminHandler        *handlers.AdminHandler
+	sportHandler        *handlers.SportHandler
+	venueHandler        *handlers.VenueHandler
+	teamHandler         *handlers.TeamHandler
+	tournamentHandler   *handlers.TournamentHandler
+	paymentHandler      *handlers.PaymentHandler
+	costHandler         *handlers.CostHandler
+	commentHandler      *handlers.CommentHandler
+	realtimeHandler     *handlers.RealtimeHandler
+	notificationHandler *handlers.NotificationHandler
+	templateHandler     *handlers.TemplateHandler
+	calendarHandler     *handlers.CalendarHandler
+	userHandler         *handlers.UserHandler
+	reportHandler       *handlers.ReportHandler
+	moderationHandler   *handlers.ModerationHandler
+	auditHandler        *handlers.AuditHandler
+	jwtManager          *jwt.Manager
+	accountChecker      middlewares.AccountChecker
+	cfg                 *config.Config
 }
 
 func NewRouter(
@@ -23,22 +39,55 @@ func NewRouter(
 	meHandler *handlers.MeHandler,
 	eventHandler *handlers.EventHandler,
 	adminHandler *handlers.AdminHandler,
+	sportHandler *handlers.SportHandler,
+	venueHandler *handlers.VenueHandler,
+	teamHandler *handlers.TeamHandler,
+	tournamentHandler *handlers.TournamentHandler,
+	paymentHandler *handlers.PaymentHandler,
+	costHandler *handlers.CostHandler,
+	commentHandler *handlers.CommentHandler,
+	realtimeHandler *handlers.RealtimeHandler,
+	notificationHandler *handlers.NotificationHandler,
+	templateHandler *handlers.TemplateHandler,
+	calendarHandler *handlers.CalendarHandler,
+	userHandler *handlers.UserHandler,
+	reportHandler *handlers.ReportHandler,
+	moderationHandler *handlers.ModerationHandler,
+	auditHandler *handlers.AuditHandler,
 	jwtManager *jwt.Manager,
+	accountChecker middlewares.AccountChecker,
 	cfg *config.Config,
 ) *Router {
 	return &Router{
-		authHandler:  authHandler,
-		meHandler:    meHandler,
-		eventHandler: eventHandler,
-		adminHandler: adminHandler,
-		jwtManager:   jwtManager,
-		cfg:          cfg,
+		authHandler:         authHandler,
+		meHandler:           meHandler,
+		eventHandler:        eventHandler,
+		adminHandler:        adminHandler,
+		sportHandler:        sportHandler,
+		venueHandler:        venueHandler,
+		teamHandler:         teamHandler,
+		tournamentHandler:   tournamentHandler,
+		paymentHandler:      paymentHandler,
+		costHandler:         costHandler,
+		commentHandler:      commentHandler,
+		realtimeHandler:     realtimeHandler,
+		notificationHandler: notificationHandler,
+		templateHandler:     templateHandler,
+		calendarHandler:     calendarHandler,
+		userHandler:         userHandler,
+		reportHandler:       reportHandler,
+		moderationHandler:   moderationHandler,
+		auditHandler:        auditHandler,
+		jwtManager:          jwtManager,
+		accountChecker:      accountChecker,
+		cfg:                 cfg,
 	}
 }
 
 func (r *Router) Setup(router *gin.Engine) {
 	// CORS middleware
 	router.Use(middlewares.CORS(r.cfg.AllowedOrigins))
+	router.Use(middlewares.RequestID())
 
 	// Health check
 	router.GET("/health", handlers.HealthCheck)
@@ -63,26 +112,137 @@ func (r *Router) Setup(router *gin.Engine) {
 	}
 
 	// Protected routes (require JWT)
-	jwtAuth := middlewares.JWTAuth(r.jwtManager)
+	jwtAuth := middlewares.JWTAuth(r.jwtManager, r.accountChecker)
+	optionalAuth := middlewares.OptionalJWTAuth(r.jwtManager, r.accountChecker)
+	streamAuth := middlewares.StreamJWTAuth(r.jwtManager, r.accountChecker)
 
 	// Me routes
 	router.GET("/me", jwtAuth, r.meHandler.GetMe)
 	router.PUT("/me", jwtAuth, r.meHandler.UpdateMe)
+	router.GET("/me/skills", jwtAuth, r.meHandler.ListMySkills)
+	router.PUT("/me/skills", jwtAuth, r.meHandler.SetMySkill)
+	router.DELETE("/me/skills/:sport", jwtAuth, r.meHandler.DeleteMySkill)
+	router.GET("/me/costs", jwtAuth, r.costHandler.ListMyCosts)
+	router.GET("/me/drafts", jwtAuth, r.eventHandler.ListMyDrafts)
+	router.GET("/me/event-templates", jwtAuth, r.templateHandler.ListTemplates)
+	router.POST("/me/event-templates", jwtAuth, r.templateHandler.CreateTemplate)
+	router.GET("/me/event-templates/:id", jwtAuth, r.templateHandler.GetTemplate)
+	router.PUT("/me/event-templates/:id", jwtAuth, r.templateHandler.UpdateTemplate)
+	router.DELETE("/me/event-templates/:id", jwtAuth, r.templateHandler.DeleteTemplate)
+	router.POST("/me/event-templates/:id/events", jwtAuth, r.templateHandler.CreateEventFromTemplate)
+	router.GET("/me/calendar-feed", jwtAuth, r.calendarHandler.GetCalendarFeed)
+	router.POST("/me/calendar-feed", jwtAuth, r.calendarHandler.CreateCalendarFeed)
+	router.DELETE("/me/calendar-feed", jwtAuth, r.calendarHandler.DeleteCalendarFeed)
+
+	// Calendar subscriptions authenticate with the secret token in the URL
+	router.GET("/calendar/:token", r.calendarHandler.GetFeedICS)
+	router.GET("/me/notifications", jwtAuth, r.notificationHandler.ListNotifications)
+	router.GET("/me/notifications/unread-count", jwtAuth, r.notificationHandler.GetUnreadCount)
+	router.POST("/me/notifications/read", jwtAuth, r.notificationHandler.MarkRead)
+	router.GET("/me/notification-preferences", jwtAuth, r.notificationHandler.GetPreferences)
+	router.PUT("/me/notification-preferences", jwtAuth, r.notificationHandler.UpdatePreferences)
+	router.GET("/me/devices", jwtAuth, r.notificationHandler.ListDevices)
+	router.POST("/me/devices", jwtAuth, r.notificationHandler.RegisterDevice)
+	router.DELETE("/me/devices/:id", jwtAuth, r.notificationHandler.RemoveDevice)
+	router.GET("/me/blocks", jwtAuth, r.userHandler.ListMyBlocks)
+
+	// User profiles and follows
+	users := router.Group("/users")
+	{
+		users.GET("/:id", optionalAuth, r.userHandler.GetUser)
+		users.GET("/:id/followers", r.userHandler.ListFollowers)
+		users.GET("/:id/following", r.userHandler.ListFollowing)
+		users.POST("/:id/follow", jwtAuth, r.userHandler.FollowUser)
+		users.DELETE("/:id/follow", jwtAuth, r.userHandler.UnfollowUser)
+		users.POST("/:id/block", jwtAuth, r.userHandler.BlockUser)
+		users.DELETE("/:id/block", jwtAuth, r.userHandler.UnblockUser)
+	}
+
+	// Reports go to the admin moderation queue
+	router.POST("/reports", jwtAuth, r.reportHandler.CreateReport)
+
+	// Sport catalog
+	router.GET("/sports", r.sportHandler.ListSports)
+
+	// Venue routes
+	venues := router.Group("/venues")
+	{
+		venues.GET("", r.venueHandler.ListVenues)
+		venues.GET("/:id", r.venueHandler.GetVenue)
+		venues.POST("", jwtAuth, r.venueHandler.CreateVenue)
+		venues.PUT("/:id", jwtAuth, r.venueHandler.UpdateVenue)
+	}
 
 	// Event routes
 	events := router.Group("/events")
 	{
 		// Public routes
-		events.GET("", r.eventHandler.ListEvents)
-		events.GET("/:id", r.eventHandler.GetEvent)
+		events.GET("", optionalAuth, r.eventHandler.ListEvents)
+		events.GET("/:id", optionalAuth, r.eventHandler.GetEvent)
 
 		// Protected routes
 		events.POST("", jwtAuth, r.eventHandler.CreateEvent)
 		events.PUT("/:id", jwtAuth, r.eventHandler.UpdateEvent)
 		events.DELETE("/:id", jwtAuth, r.eventHandler.DeleteEvent)
+		events.POST("/:id/publish", jwtAuth, r.eventHandler.PublishEvent)
+		events.POST("/:id/clone", jwtAuth, r.eventHandler.CloneEvent)
+		events.DELETE("/:id/publish", jwtAuth, r.eventHandler.UnschedulePublish)
 		events.POST("/:id/join", jwtAuth, r.eventHandler.JoinEvent)
 		events.POST("/:id/leave", jwtAuth, r.eventHandler.LeaveEvent)
 		events.POST("/:id/swipe", jwtAuth, r.eventHandler.SwipeEvent)
+		events.GET("/:id/cohosts", optionalAuth, r.eventHandler.ListCoHosts)
+		events.POST("/:id/cohosts", jwtAuth, r.eventHandler.AddCoHost)
+		events.DELETE("/:id/cohosts/:userId", jwtAuth, r.eventHandler.RemoveCoHost)
+		events.POST("/:id/transfer", jwtAuth, r.eventHandler.TransferOwnership)
+		events.GET("/:id/participants", jwtAuth, r.eventHandler.ListParticipants)
+		events.GET("/:id/participants/me", jwtAuth, r.eventHandler.GetMyParticipation)
+		events.POST("/:id/participants/:userId/remove", jwtAuth, r.eventHandler.RemoveParticipant)
+		events.POST("/:id/participants/:userId/ban", jwtAuth, r.eventHandler.BanParticipant)
+		events.DELETE("/:id/participants/:userId/ban", jwtAuth, r.eventHandler.UnbanParticipant)
+		events.POST("/:id/participants/:userId/check-in", jwtAuth, r.eventHandler.CheckInParticipant)
+		events.DELETE("/:id/participants/:userId/check-in", jwtAuth, r.eventHandler.UndoCheckIn)
+		events.GET("/:id/teams", optionalAuth, r.teamHandler.ListTeams)
+		events.POST("/:id/teams", jwtAuth, r.teamHandler.GenerateTeams)
+		events.DELETE("/:id/teams", jwtAuth, r.teamHandler.DeleteTeams)
+		events.GET("/:id/tournament", optionalAuth, r.tournamentHandler.GetEventTournament)
+		events.POST("/:id/tournament", jwtAuth, r.tournamentHandler.CreateTournament)
+		events.GET("/:id/costs", jwtAuth, r.costHandler.GetCostSplit)
+		events.POST("/:id/costs", jwtAuth, r.costHandler.SplitCosts)
+		events.DELETE("/:id/costs", jwtAuth, r.costHandler.DeleteCostSplit)
+		events.POST("/:id/costs/shares/:userId/settle", jwtAuth, r.costHandler.SettleShare)
+		events.DELETE("/:id/costs/shares/:userId/settle", jwtAuth, r.costHandler.UnsettleShare)
+		events.POST("/:id/costs/remind", jwtAuth, r.costHandler.SendCostReminders)
+		events.GET("/:id/comments", optionalAuth, r.commentHandler.ListComments)
+		events.POST("/:id/comments", jwtAuth, r.commentHandler.CreateComment)
+		events.PUT("/:id/comments/:commentId", jwtAuth, r.commentHandler.UpdateComment)
+		events.DELETE("/:id/comments/:commentId", jwtAuth, r.commentHandler.DeleteComment)
+		events.POST("/:id/comments/:commentId/pin", jwtAuth, r.commentHandler.PinComment)
+		events.DELETE("/:id/comments/:commentId/pin", jwtAuth, r.commentHandler.UnpinComment)
+		events.GET("/:id/stream", streamAuth, r.realtimeHandler.StreamEvent)
+		events.GET("/:id/ics", optionalAuth, r.calendarHandler.GetEventICS)
+	}
+
+	// Realtime streams
+	router.GET("/stream/feed", streamAuth, r.realtimeHandler.StreamFeed)
+
+	// Tournament routes
+	tournaments := router.Group("/tournaments")
+	{
+		tournaments.GET("/:id/teams", optionalAuth, r.tournamentHandler.ListTeams)
+		tournaments.POST("/:id/teams", jwtAuth, r.tournamentHandler.RegisterTeam)
+		tournaments.DELETE("/:id/teams/:teamId", jwtAuth, r.tournamentHandler.WithdrawTeam)
+		tournaments.POST("/:id/bracket", jwtAuth, r.tournamentHandler.GenerateBracket)
+		tournaments.GET("/:id/matches", optionalAuth, r.tournamentHandler.ListMatches)
+		tournaments.PUT("/:id/matches/:matchId/schedule", jwtAuth, r.tournamentHandler.ScheduleMatch)
+		tournaments.PUT("/:id/matches/:matchId/result", jwtAuth, r.tournamentHandler.RecordResult)
+		tournaments.GET("/:id/standings", optionalAuth, r.tournamentHandler.GetStandings)
+	}
+
+	// Payment routes; callbacks are authenticated by the provider signature
+	paymentRoutes := router.Group("/payments")
+	{
+		paymentRoutes.POST("/webhook", r.paymentHandler.Webhook)
+		paymentRoutes.POST("/fake/:ref", jwtAuth, r.paymentHandler.FakeCheckout)
 	}
 
 	// Admin routes (require admin role)
@@ -91,7 +251,29 @@ func (r *Router) Setup(router *gin.Engine) {
 	{
 		admin.GET("/users", r.adminHandler.ListUsers)
 		admin.PUT("/users/:id/role", r.adminHandler.UpdateUserRole)
+		admin.POST("/users/:id/suspend", r.moderationHandler.SuspendUser)
+		admin.POST("/users/:id/ban", r.moderationHandler.BanUser)
+		admin.POST("/users/:id/unban", r.moderationHandler.UnbanUser)
 		admin.GET("/events", r.adminHandler.ListAllEvents)
 		admin.PUT("/events/:id/status", r.adminHandler.UpdateEventStatus)
+		admin.POST("/events/:id/uncancel", r.adminHandler.UncancelEvent)
+		admin.POST("/events/:id/hide", r.moderationHandler.HideEvent)
+		admin.DELETE("/events/:id/hide", r.moderationHandler.UnhideEvent)
+		admin.GET("/sports", r.sportHandler.AdminListSports)
+		admin.POST("/sports", r.sportHandler.CreateSport)
+		admin.PUT("/sports/:id", r.sportHandler.UpdateSport)
+		admin.DELETE("/sports/:id", r.sportHandler.DeleteSport)
+		admin.POST("/venues/:id/merge", r.venueHandler.MergeVenues)
+		admin.GET("/comments", r.commentHandler.AdminListComments)
+		admin.POST("/comments/:id/hide", r.commentHandler.HideComment)
+		admin.DELETE("/comments/:id/hide", r.commentHandler.UnhideComment)
+		admin.GET("/reports", r.reportHandler.AdminListReports)
+		admin.GET("/reports/:id", r.moderationHandler.GetReport)
+		admin.POST("/reports/:id/claim", r.moderationHandler.ClaimReport)
+		admin.DELETE("/reports/:id/claim", r.moderationHandler.ReleaseReport)
+		admin.POST("/reports/:id/resolve", r.moderationHandler.ResolveReport)
+		admin.GET("/moderation-actions", r.moderationHandler.ListActions)
+		admin.GET("/audit-logs", r.auditHandler.ListAuditLogs)
+		admin.GET("/audit-logs/export", r.auditHandler.ExportAuditLogs)
 	}
 }
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrAccountSuspended is returned when a suspended user tries to use the API
+var ErrAccountSuspended = errors.New("account is suspended")
+
+// ErrAccountBanned is returned when a banned user tries to use the API
+var ErrAccountBanned = errors.New("account is banned")
+
+// CheckAccountStatus returns why the user may not use the API at the given time, or
+// nil. Suspensions end by themselves once SuspendedUntil has passed.
+func CheckAccountStatus(user *models.User, now time.Time) error {
+	switch user.Status {
+	case models.UserStatusBanned:
+		return fmt.Errorf("%w%s", ErrAccountBanned, statusReason(user))
+	case models.UserStatusSuspended:
+		if user.SuspendedUntil == nil {
+			return fmt.Errorf("%w%s", ErrAccountSuspended, statusReason(user))
+		}
+		if now.Before(*user.SuspendedUntil) {
+			return fmt.Errorf("%w until %s%s", ErrAccountSuspended, user.SuspendedUntil.UTC().Format(time.RFC3339), statusReason(user))
+		}
+	}
+	return nil
+}
+
+func statusReason(user *models.User) string {
+	if user.StatusReason == nil {
+		return ""
+	}
+	return ": " + *user.StatusReason
+}
+
+// AccountService suspends, bans and reinstates accounts. Restricting an account
+// signs the user out everywhere and hands off or cancels the events they organize.
+type AccountService struct {
+	userRepo     *repositories.UserRepository
+	tokenRepo    *repositories.TokenRepository
+	eventService *EventService
+	auditService *AuditService
+}
+
+func NewAccountService(userRepo *repositories.UserRepository, tokenRepo *repositories.TokenRepository, eventService *EventService, auditService *AuditService) *AccountService {
+	return &AccountService{
+		userRepo:     userRepo,
+		tokenRepo:    tokenRepo,
+		eventService: eventService,
+		auditService: auditService,
+	}
+}
+
+// CheckAccount returns why the user may not use the API right now, or nil. It is
+// run on every authenticated request so restrictions apply to issued access tokens.
+func (s *AccountService) CheckAccount(userID uuid.UUID) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+	return CheckAccountStatus(user, time.Now().UTC())
+}
+
+// Suspend locks the user out until the given time. Their events starting before
+// then are handed to a co-host or cancelled.
+func (s *AccountService) Suspend(id, adminID uuid.UUID, until time.Time, reason string) (*models.User, error) {
+	user, err := s.findRestrictable(id, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status == models.UserStatusBanned {
+		return nil, errors.New("user is banned")
+	}
+	if !until.After(time.Now().UTC()) {
+		return nil, errors.New("suspension must end in the future")
+	}
+
+	until = until.UTC()
+	user.Status = models.UserStatusSuspended
+	user.SuspendedUntil = &until
+	if err := s.restrict(user, adminID, reason, &until); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Ban locks the user out until an admin lifts the ban. All their upcoming events
+// are handed to a co-host or cancelled.
+func (s *AccountService) Ban(id, adminID uuid.UUID, reason string) (*models.User, error) {
+	user, err := s.findRestrictable(id, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status == models.UserStatusBanned {
+		return nil, errors.New("user is already banned")
+	}
+
+	user.Status = models.UserStatusBanned
+	user.SuspendedUntil = nil
+	if err := s.restrict(user, adminID, reason, nil); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Unban lifts a ban or a suspension that has not ended yet. Events that were handed
+// off or cancelled stay that way.
+func (s *AccountService) Unban(id, adminID uuid.UUID, reason string) (*models.User, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	if CheckAccountStatus(user, time.Now().UTC()) == nil {
+		return nil, errors.New("user is not suspended or banned")
+	}
+	if _, err := accountReason(reason); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	user.Status = models.UserStatusActive
+	user.SuspendedUntil = nil
+	user.StatusReason = nil
+	user.StatusChangedAt = &now
+	user.StatusChangedBy = &adminID
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// findRestrictable loads a user an admin may suspend or ban
+func (s *AccountService) findRestrictable(id, adminID uuid.UUID) (*models.User, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if id == adminID {
+		return nil, errors.New("you cannot restrict your own account")
+	}
+	if user.Role == "admin" {
+		return nil, errors.New("admins cannot be suspended or banned; demote them first")
+	}
+	return user, nil
+}
+
+// restrict stores the new status, signs the user out and releases their events
+// starting before until (all of them when until is nil)
+func (s *AccountService) restrict(user *models.User, adminID uuid.UUID, reason string, until *time.Time) error {
+	reason, err := accountReason(reason)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	user.StatusReason = &reason
+	user.StatusChangedAt = &now
+	user.StatusChangedBy = &adminID
+	if err := s.userRepo.Update(user); err != nil {
+		return err
+	}
+
+	// Access tokens are rejected by the auth middleware; refresh tokens go now
+	if err := s.tokenRepo.RevokeAllForUser(user.ID); err != nil {
+		log.Printf("accounts: failed to revoke refresh tokens of user %s: %v", user.ID, err)
+	} else {
+		s.auditService.Record(AuditMeta{ActorID: &adminID}, AuditEntry{
+			Action:     models.AuditActionAllTokensRevoked,
+			TargetType: models.AuditTargetUser,
+			TargetID:   &user.ID,
+			After:      map[string]interface{}{"status": user.Status, "reason": reason},
+		})
+	}
+
+	if err := s.eventService.ReleaseOrganizedEvents(user.ID, adminID, until, "The organizer can no longer host this event."); err != nil {
+		log.Printf("accounts: failed to release events of user %s: %v", user.ID, err)
+	}
+	return nil
+}
+
+func accountReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", errors.New("a reason is required")
+	}
+	if len(reason) > 500 {
+		return "", errors.New("reason must be at most 500 characters")
+	}
+	return reason, nil
+}
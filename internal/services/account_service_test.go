@@ -0,0 +1,59 @@
+package services_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"playspotter/internal/models"
+	"playspotter/internal/services"
+)
+
+func TestCheckAccountStatus(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	later := now.Add(48 * time.Hour)
+	earlier := now.Add(-time.Hour)
+	reason := "spam"
+
+	tests := []struct {
+		name string
+		user models.User
+		want error
+	}{
+		{"Active", models.User{Status: models.UserStatusActive}, nil},
+		{"Suspended", models.User{Status: models.UserStatusSuspended, SuspendedUntil: &later, StatusReason: &reason}, services.ErrAccountSuspended},
+		{"Suspension Ended", models.User{Status: models.UserStatusSuspended, SuspendedUntil: &earlier}, nil},
+		{"Suspended Without End", models.User{Status: models.UserStatusSuspended}, services.ErrAccountSuspended},
+		{"Banned", models.User{Status: models.UserStatusBanned, StatusReason: &reason}, services.ErrAccountBanned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := services.CheckAccountStatus(&tt.user, now)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, err)
+			}
+			if tt.user.StatusReason != nil && !strings.HasSuffix(err.Error(), ": "+reason) {
+				t.Errorf("Expected the reason in %q", err.Error())
+			}
+		})
+	}
+}
+
+func TestCheckAccountStatusShowsSuspensionEnd(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 3, 17, 12, 0, 0, 0, time.UTC)
+	user := models.User{Status: models.UserStatusSuspended, SuspendedUntil: &until}
+
+	err := services.CheckAccountStatus(&user, now)
+	if err == nil || !strings.Contains(err.Error(), "2026-03-17T12:00:00Z") {
+		t.Errorf("Expected the end of the suspension in the error, got %v", err)
+	}
+}
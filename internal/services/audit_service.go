@@ -0,0 +1,85 @@
+package services
+
+import (
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"reflect"
+
+	"github.com/google/uuid"
+)
+
+// AuditMeta identifies the request behind an audited action
+type AuditMeta struct {
+	// ActorID is the signed-in user, if any
+	ActorID   *uuid.UUID
+	IP        string
+	RequestID string
+}
+
+// AuditEntry is an action to write to the audit log. Before and After are reduced
+// to the fields that changed.
+type AuditEntry struct {
+	Action     string
+	TargetType string
+	TargetID   *uuid.UUID
+	Before     map[string]interface{}
+	After      map[string]interface{}
+}
+
+type AuditService struct {
+	auditRepo *repositories.AuditRepository
+}
+
+func NewAuditService(auditRepo *repositories.AuditRepository) *AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+// Record writes an entry to the audit log. The action has already happened, so a
+// failure is logged rather than returned. A nil service records nothing.
+func (s *AuditService) Record(meta AuditMeta, entry AuditEntry) {
+	if s == nil {
+		return
+	}
+
+	before, after := AuditDiff(entry.Before, entry.After)
+	err := s.auditRepo.Create(&models.AuditLog{
+		ActorID:    meta.ActorID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Before:     before,
+		After:      after,
+		IP:         meta.IP,
+		RequestID:  meta.RequestID,
+	})
+	if err != nil {
+		log.Printf("audit: failed to record %s on %s %v: %v", entry.Action, entry.TargetType, entry.TargetID, err)
+	}
+}
+
+// List returns audit log entries, newest first
+func (s *AuditService) List(filter repositories.AuditFilter) ([]models.AuditLog, int64, error) {
+	return s.auditRepo.List(filter)
+}
+
+// AuditDiff keeps only the fields whose values differ between before and after.
+// Fields missing on one side are kept on the other.
+func AuditDiff(before, after map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	changedBefore := map[string]interface{}{}
+	changedAfter := map[string]interface{}{}
+
+	for key, value := range before {
+		other, ok := after[key]
+		if !ok || !reflect.DeepEqual(value, other) {
+			changedBefore[key] = value
+		}
+	}
+	for key, value := range after {
+		other, ok := before[key]
+		if !ok || !reflect.DeepEqual(value, other) {
+			changedAfter[key] = value
+		}
+	}
+	return changedBefore, changedAfter
+}
@@ -0,0 +1,59 @@
+package services_test
+
+import (
+	"reflect"
+	"testing"
+
+	"playspotter/internal/services"
+)
+
+func TestAuditDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		before     map[string]interface{}
+		after      map[string]interface{}
+		wantBefore map[string]interface{}
+		wantAfter  map[string]interface{}
+	}{
+		{
+			name:       "Changed Field",
+			before:     map[string]interface{}{"role": "user", "name": "Ana"},
+			after:      map[string]interface{}{"role": "admin", "name": "Ana"},
+			wantBefore: map[string]interface{}{"role": "user"},
+			wantAfter:  map[string]interface{}{"role": "admin"},
+		},
+		{
+			name:       "Added Field",
+			before:     map[string]interface{}{"status": "open"},
+			after:      map[string]interface{}{"status": "cancelled", "cancellation_reason": "Rain"},
+			wantBefore: map[string]interface{}{"status": "open"},
+			wantAfter:  map[string]interface{}{"status": "cancelled", "cancellation_reason": "Rain"},
+		},
+		{
+			name:       "Only After",
+			before:     nil,
+			after:      map[string]interface{}{"email": "admin@example.com"},
+			wantBefore: map[string]interface{}{},
+			wantAfter:  map[string]interface{}{"email": "admin@example.com"},
+		},
+		{
+			name:       "Nothing Changed",
+			before:     map[string]interface{}{"revoked": true},
+			after:      map[string]interface{}{"revoked": true},
+			wantBefore: map[string]interface{}{},
+			wantAfter:  map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, after := services.AuditDiff(tt.before, tt.after)
+			if !reflect.DeepEqual(before, tt.wantBefore) {
+				t.Errorf("Expected before %v, got %v", tt.wantBefore, before)
+			}
+			if !reflect.DeepEqual(after, tt.wantAfter) {
+				t.Errorf("Expected after %v, got %v", tt.wantAfter, after)
+			}
+		})
+	}
+}
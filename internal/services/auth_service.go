@@ -7,22 +7,26 @@ import (
 	"playspotter/internal/models"
 	"playspotter/internal/repositories"
 	"playspotter/pkg/jwt"
+	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
 type AuthService struct {
-	userRepo  *repositories.UserRepository
-	tokenRepo *repositories.TokenRepository
-	jwtMgr    *jwt.Manager
+	userRepo     *repositories.UserRepository
+	tokenRepo    *repositories.TokenRepository
+	jwtMgr       *jwt.Manager
+	auditService *AuditService
 }
 
-func NewAuthService(userRepo *repositories.UserRepository, tokenRepo *repositories.TokenRepository, jwtMgr *jwt.Manager) *AuthService {
+func NewAuthService(userRepo *repositories.UserRepository, tokenRepo *repositories.TokenRepository, jwtMgr *jwt.Manager, auditService *AuditService) *AuthService {
 	return &AuthService{
-		userRepo:  userRepo,
-		tokenRepo: tokenRepo,
-		jwtMgr:    jwtMgr,
+		userRepo:     userRepo,
+		tokenRepo:    tokenRepo,
+		jwtMgr:       jwtMgr,
+		auditService: auditService,
 	}
 }
 
@@ -56,10 +60,12 @@ func (s *AuthService) Register(name, email, password string) (*models.User, erro
 	return user, nil
 }
 
-func (s *AuthService) Login(email, password string) (string, string, *models.User, error) {
+// Login signs the user in. Successful and failed attempts go to the audit log.
+func (s *AuthService) Login(email, password string, meta AuditMeta) (string, string, *models.User, error) {
 	user, err := s.userRepo.FindByEmail(email)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			s.auditLoginFailed(meta, email, nil, "unknown_email")
 			return "", "", nil, errors.New("invalid credentials")
 		}
 		return "", "", nil, err
@@ -67,9 +73,16 @@ func (s *AuthService) Login(email, password string) (string, string, *models.Use
 
 	// Verify password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		s.auditLoginFailed(meta, email, &user.ID, "wrong_password")
 		return "", "", nil, errors.New("invalid credentials")
 	}
 
+	// Suspended and banned users cannot sign in
+	if err := CheckAccountStatus(user, time.Now().UTC()); err != nil {
+		s.auditLoginFailed(meta, email, &user.ID, "account_"+user.Status)
+		return "", "", nil, err
+	}
+
 	// Generate access token
 	accessToken, err := s.jwtMgr.GenerateAccessToken(user.ID, user.Role)
 	if err != nil {
@@ -92,9 +105,24 @@ func (s *AuthService) Login(email, password string) (string, string, *models.Use
 		return "", "", nil, err
 	}
 
+	meta.ActorID = &user.ID
+	s.auditService.Record(meta, AuditEntry{
+		Action:     models.AuditActionLogin,
+		TargetType: models.AuditTargetUser,
+		TargetID:   &user.ID,
+	})
 	return accessToken, refreshToken, user, nil
 }
 
+func (s *AuthService) auditLoginFailed(meta AuditMeta, email string, userID *uuid.UUID, reason string) {
+	s.auditService.Record(meta, AuditEntry{
+		Action:     models.AuditActionLoginFailed,
+		TargetType: models.AuditTargetUser,
+		TargetID:   userID,
+		After:      map[string]interface{}{"email": email, "reason": reason},
+	})
+}
+
 func (s *AuthService) RefreshToken(refreshToken string) (string, string, error) {
 	// Validate refresh token
 	_, err := s.jwtMgr.ValidateRefreshToken(refreshToken)
@@ -115,6 +143,10 @@ func (s *AuthService) RefreshToken(refreshToken string) (string, string, error)
 		return "", "", err
 	}
 
+	if err := CheckAccountStatus(user, time.Now().UTC()); err != nil {
+		return "", "", err
+	}
+
 	// Revoke old refresh token
 	if err := s.tokenRepo.Revoke(tokenHash); err != nil {
 		return "", "", err
@@ -144,12 +176,34 @@ func (s *AuthService) RefreshToken(refreshToken string) (string, string, error)
 	return newAccessToken, newRefreshToken, nil
 }
 
-func (s *AuthService) Logout(refreshToken string) error {
+// Logout revokes the refresh token and records the revocation in the audit log
+func (s *AuthService) Logout(refreshToken string, meta AuditMeta) error {
 	tokenHash := hashToken(refreshToken)
-	return s.tokenRepo.Revoke(tokenHash)
+	storedToken, err := s.tokenRepo.FindByHash(tokenHash)
+	if err != nil {
+		// Unknown, expired or already revoked: nothing to revoke
+		return nil
+	}
+
+	if err := s.tokenRepo.Revoke(tokenHash); err != nil {
+		return err
+	}
+
+	if meta.ActorID == nil {
+		meta.ActorID = &storedToken.UserID
+	}
+	s.auditService.Record(meta, AuditEntry{
+		Action:     models.AuditActionTokenRevoked,
+		TargetType: models.AuditTargetRefreshToken,
+		TargetID:   &storedToken.ID,
+		Before:     map[string]interface{}{"revoked": false},
+		After:      map[string]interface{}{"revoked": true},
+	})
+	return nil
 }
 
-func (s *AuthService) BootstrapAdmin(email, password string) error {
+// BootstrapAdmin creates the first admin and records it in the audit log
+func (s *AuthService) BootstrapAdmin(email, password string, meta AuditMeta) error {
 	// Check if admin already exists
 	count, err := s.userRepo.CountByRole("admin")
 	if err != nil {
@@ -172,7 +226,17 @@ func (s *AuthService) BootstrapAdmin(email, password string) error {
 		Role:         "admin",
 	}
 
-	return s.userRepo.Create(admin)
+	if err := s.userRepo.Create(admin); err != nil {
+		return err
+	}
+
+	s.auditService.Record(meta, AuditEntry{
+		Action:     models.AuditActionAdminBootstrapped,
+		TargetType: models.AuditTargetUser,
+		TargetID:   &admin.ID,
+		After:      map[string]interface{}{"email": admin.Email, "role": admin.Role},
+	})
+	return nil
 }
 
 func hashToken(token string) string {
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+type BlockService struct {
+	blockRepo  *repositories.BlockRepository
+	followRepo *repositories.FollowRepository
+	userRepo   *repositories.UserRepository
+}
+
+func NewBlockService(blockRepo *repositories.BlockRepository, followRepo *repositories.FollowRepository, userRepo *repositories.UserRepository) *BlockService {
+	return &BlockService{
+		blockRepo:  blockRepo,
+		followRepo: followRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Block stops the two users from seeing each other's events in the feed and from
+// joining each other's events. Follows between them are removed.
+func (s *BlockService) Block(blockerID, blockedID uuid.UUID) error {
+	if blockerID == blockedID {
+		return errors.New("you cannot block yourself")
+	}
+	if _, err := s.userRepo.FindByID(blockedID); err != nil {
+		return ErrUserNotFound
+	}
+
+	if err := s.blockRepo.Create(&models.UserBlock{BlockerID: blockerID, BlockedID: blockedID}); err != nil {
+		return err
+	}
+	if err := s.followRepo.Delete(blockerID, blockedID); err != nil {
+		return err
+	}
+	return s.followRepo.Delete(blockedID, blockerID)
+}
+
+func (s *BlockService) Unblock(blockerID, blockedID uuid.UUID) error {
+	return s.blockRepo.Delete(blockerID, blockedID)
+}
+
+// ListBlocked returns the users the blocker blocked, most recent first
+func (s *BlockService) ListBlocked(blockerID uuid.UUID, offset, limit int) ([]models.User, int64, error) {
+	blocks, total, err := s.blockRepo.ListByBlocker(blockerID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users := make([]models.User, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Blocked != nil {
+			users = append(users, *block.Blocked)
+		}
+	}
+	return users, total, nil
+}
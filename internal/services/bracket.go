@@ -0,0 +1,210 @@
+package services
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// BracketMatch is a generated fixture; Next is the index of the match the winner
+// advances to (-1 when there is none) and NextSlot is "home" or "away"
+type BracketMatch struct {
+	Round    int
+	Position int
+	Home     *uuid.UUID
+	Away     *uuid.UUID
+	Next     int
+	NextSlot string
+}
+
+// IsBye reports whether only one side of the match has a team
+func (m BracketMatch) IsBye() bool {
+	return (m.Home == nil) != (m.Away == nil)
+}
+
+// SingleEliminationBracket builds a knockout bracket for teams listed in seed order.
+// The field is padded to a power of two and the top seeds receive byes.
+func SingleEliminationBracket(teams []uuid.UUID) ([]BracketMatch, error) {
+	if len(teams) < 2 {
+		return nil, errors.New("at least 2 teams are required")
+	}
+
+	size := 1
+	for size < len(teams) {
+		size *= 2
+	}
+
+	// Standard seeding keeps the top seeds apart until the late rounds: 1v8, 4v5, 2v7, 3v6
+	order := []int{1}
+	for len(order) < size {
+		n := len(order) * 2
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+
+	seedTeam := func(seed int) *uuid.UUID {
+		if seed > len(teams) {
+			return nil
+		}
+		id := teams[seed-1]
+		return &id
+	}
+
+	var matches []BracketMatch
+	roundStart := map[int]int{}
+	rounds := 0
+	for n := size; n > 1; n /= 2 {
+		rounds++
+	}
+
+	for round := 1; round <= rounds; round++ {
+		roundStart[round] = len(matches)
+		count := size >> round
+		for pos := 0; pos < count; pos++ {
+			m := BracketMatch{Round: round, Position: pos + 1, Next: -1}
+			if round == 1 {
+				m.Home = seedTeam(order[pos*2])
+				m.Away = seedTeam(order[pos*2+1])
+			}
+			matches = append(matches, m)
+		}
+	}
+
+	for i := range matches {
+		m := &matches[i]
+		if m.Round == rounds {
+			continue
+		}
+		pos := m.Position - 1
+		m.Next = roundStart[m.Round+1] + pos/2
+		m.NextSlot = "home"
+		if pos%2 == 1 {
+			m.NextSlot = "away"
+		}
+	}
+
+	return matches, nil
+}
+
+// RoundRobinSchedule pairs every team with every other team once using the circle method
+func RoundRobinSchedule(teams []uuid.UUID) ([]BracketMatch, error) {
+	if len(teams) < 2 {
+		return nil, errors.New("at least 2 teams are required")
+	}
+
+	slots := make([]*uuid.UUID, 0, len(teams)+1)
+	for i := range teams {
+		id := teams[i]
+		slots = append(slots, &id)
+	}
+	if len(slots)%2 == 1 {
+		slots = append(slots, nil)
+	}
+
+	n := len(slots)
+	var matches []BracketMatch
+	for round := 1; round < n; round++ {
+		pos := 0
+		for i := 0; i < n/2; i++ {
+			home, away := slots[i], slots[n-1-i]
+			if home == nil || away == nil {
+				continue
+			}
+			// Alternate the fixed team's home games
+			if i == 0 && round%2 == 0 {
+				home, away = away, home
+			}
+			pos++
+			matches = append(matches, BracketMatch{Round: round, Position: pos, Home: home, Away: away, Next: -1})
+		}
+
+		// Rotate every slot but the first
+		last := slots[n-1]
+		copy(slots[2:], slots[1:n-1])
+		slots[1] = last
+	}
+
+	return matches, nil
+}
+
+// MatchResult is a completed match used to compute standings
+type MatchResult struct {
+	Home      uuid.UUID
+	Away      uuid.UUID
+	HomeScore int
+	AwayScore int
+}
+
+// Standing is one row of a league table
+type Standing struct {
+	TeamID       uuid.UUID `json:"team_id"`
+	TeamName     string    `json:"team_name"`
+	Played       int       `json:"played"`
+	Won          int       `json:"won"`
+	Drawn        int       `json:"drawn"`
+	Lost         int       `json:"lost"`
+	ScoreFor     int       `json:"score_for"`
+	ScoreAgainst int       `json:"score_against"`
+	ScoreDiff    int       `json:"score_diff"`
+	Points       int       `json:"points"`
+}
+
+// ComputeStandings ranks teams by points, then score difference, score for and name
+func ComputeStandings(teamNames map[uuid.UUID]string, results []MatchResult, pointsWin, pointsDraw, pointsLoss int) []Standing {
+	rows := make(map[uuid.UUID]*Standing, len(teamNames))
+	for id, name := range teamNames {
+		rows[id] = &Standing{TeamID: id, TeamName: name}
+	}
+
+	record := func(id uuid.UUID, scored, conceded int) {
+		row, ok := rows[id]
+		if !ok {
+			return
+		}
+		row.Played++
+		row.ScoreFor += scored
+		row.ScoreAgainst += conceded
+		row.ScoreDiff = row.ScoreFor - row.ScoreAgainst
+		switch {
+		case scored > conceded:
+			row.Won++
+			row.Points += pointsWin
+		case scored == conceded:
+			row.Drawn++
+			row.Points += pointsDraw
+		default:
+			row.Lost++
+			row.Points += pointsLoss
+		}
+	}
+
+	for _, r := range results {
+		record(r.Home, r.HomeScore, r.AwayScore)
+		record(r.Away, r.AwayScore, r.HomeScore)
+	}
+
+	standings := make([]Standing, 0, len(rows))
+	for _, row := range rows {
+		standings = append(standings, *row)
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.ScoreDiff != b.ScoreDiff {
+			return a.ScoreDiff > b.ScoreDiff
+		}
+		if a.ScoreFor != b.ScoreFor {
+			return a.ScoreFor > b.ScoreFor
+		}
+		return a.TeamName < b.TeamName
+	})
+
+	return standings
+}
@@ -0,0 +1,104 @@
+package services_test
+
+import (
+	"testing"
+
+	"playspotter/internal/services"
+
+	"github.com/google/uuid"
+)
+
+func makeTeams(n int) []uuid.UUID {
+	teams := make([]uuid.UUID, n)
+	for i := range teams {
+		teams[i] = uuid.New()
+	}
+	return teams
+}
+
+// Test knockout bracket shape, seeding and byes
+func TestSingleEliminationBracket(t *testing.T) {
+	teams := makeTeams(5)
+
+	matches, err := services.SingleEliminationBracket(teams)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 8-slot bracket: 4 + 2 + 1 matches
+	if len(matches) != 7 {
+		t.Fatalf("Expected 7 matches, got %d", len(matches))
+	}
+
+	byes := 0
+	for _, m := range matches {
+		if m.Round == 1 && m.IsBye() {
+			byes++
+		}
+		if m.Round == 3 && m.Next != -1 {
+			t.Error("Expected the final to have no next match")
+		}
+		if m.Round < 3 && matches[m.Next].Round != m.Round+1 {
+			t.Errorf("Expected round %d match to feed round %d", m.Round, m.Round+1)
+		}
+	}
+	if byes != 3 {
+		t.Errorf("Expected 3 byes, got %d", byes)
+	}
+
+	// Top seed opens against a bye
+	first := matches[0]
+	if first.Home == nil || *first.Home != teams[0] || first.Away != nil {
+		t.Error("Expected seed 1 to receive a bye in the first match")
+	}
+}
+
+// Test that every pair meets exactly once
+func TestRoundRobinSchedule(t *testing.T) {
+	for _, n := range []int{4, 5} {
+		teams := makeTeams(n)
+
+		matches, err := services.RoundRobinSchedule(teams)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(matches) != n*(n-1)/2 {
+			t.Errorf("Expected %d matches for %d teams, got %d", n*(n-1)/2, n, len(matches))
+		}
+
+		seen := make(map[[2]uuid.UUID]bool)
+		for _, m := range matches {
+			a, b := *m.Home, *m.Away
+			if a.String() > b.String() {
+				a, b = b, a
+			}
+			key := [2]uuid.UUID{a, b}
+			if seen[key] {
+				t.Errorf("Pair played twice in %d-team schedule", n)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+// Test standings ordering
+func TestComputeStandings(t *testing.T) {
+	teams := makeTeams(3)
+	names := map[uuid.UUID]string{teams[0]: "Alpha", teams[1]: "Bravo", teams[2]: "Charlie"}
+
+	results := []services.MatchResult{
+		{Home: teams[0], Away: teams[1], HomeScore: 2, AwayScore: 2},
+		{Home: teams[1], Away: teams[2], HomeScore: 3, AwayScore: 0},
+		{Home: teams[2], Away: teams[0], HomeScore: 1, AwayScore: 0},
+	}
+
+	standings := services.ComputeStandings(names, results, 3, 1, 0)
+
+	if standings[0].TeamName != "Bravo" || standings[0].Points != 4 {
+		t.Errorf("Expected Bravo to lead with 4 points, got %s with %d", standings[0].TeamName, standings[0].Points)
+	}
+	if standings[2].TeamName != "Alpha" {
+		t.Errorf("Expected Alpha last, got %s", standings[2].TeamName)
+	}
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"playspotter/internal/ical"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrCalendarFeedNotFound is returned for unknown or revoked feed tokens
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// calendarFeedHistory is how far back subscribed calendars keep past games
+const calendarFeedHistory = 30 * 24 * time.Hour
+
+type CalendarService struct {
+	feedRepo     *repositories.CalendarFeedRepository
+	eventRepo    *repositories.EventRepository
+	eventService *EventService
+}
+
+func NewCalendarService(feedRepo *repositories.CalendarFeedRepository, eventRepo *repositories.EventRepository, eventService *EventService) *CalendarService {
+	return &CalendarService{
+		feedRepo:     feedRepo,
+		eventRepo:    eventRepo,
+		eventService: eventService,
+	}
+}
+
+// EventCalendar returns a calendar with the single event, if the caller may see it
+func (s *CalendarService) EventCalendar(eventID uuid.UUID, userID *uuid.UUID, isAdmin bool) (ical.Calendar, error) {
+	event, err := s.eventService.ViewEvent(eventID, userID, isAdmin)
+	if err != nil {
+		return ical.Calendar{}, err
+	}
+	return ical.Calendar{Events: []ical.Event{calendarEvent(event)}}, nil
+}
+
+// FeedCalendar returns the events of the feed the token belongs to. The events are
+// read on every request, so calendar apps pick up reschedules and cancellations
+// the next time they refresh.
+func (s *CalendarService) FeedCalendar(token string) (ical.Calendar, error) {
+	feed, err := s.feedRepo.FindByTokenHash(hashToken(token))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ical.Calendar{}, ErrCalendarFeedNotFound
+		}
+		return ical.Calendar{}, err
+	}
+
+	now := time.Now().UTC()
+	events, err := s.eventRepo.ListForCalendar(feed.UserID, feed.IncludeLiked, now.Add(-calendarFeedHistory))
+	if err != nil {
+		return ical.Calendar{}, err
+	}
+
+	if err := s.feedRepo.Touch(feed.UserID, now); err != nil {
+		log.Printf("calendar: failed to record access to the feed of user %s: %v", feed.UserID, err)
+	}
+
+	cal := ical.Calendar{Name: "PlaySpotter", Events: make([]ical.Event, 0, len(events))}
+	for i := range events {
+		cal.Events = append(cal.Events, calendarEvent(&events[i]))
+	}
+	return cal, nil
+}
+
+// GetFeed returns the user's feed settings
+func (s *CalendarService) GetFeed(userID uuid.UUID) (*models.CalendarFeed, error) {
+	feed, err := s.feedRepo.FindByUser(userID)
+	if err != nil {
+		return nil, ErrCalendarFeedNotFound
+	}
+	return feed, nil
+}
+
+// CreateFeed creates the user's feed, or replaces its token so the old
+// subscription URL stops working. The token is only ever returned here.
+func (s *CalendarService) CreateFeed(userID uuid.UUID, includeLiked bool) (string, *models.CalendarFeed, error) {
+	token, err := newFeedToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	feed := &models.CalendarFeed{
+		UserID:       userID,
+		TokenHash:    hashToken(token),
+		IncludeLiked: includeLiked,
+		CreatedAt:    time.Now().UTC(),
+	}
+	if err := s.feedRepo.Save(feed); err != nil {
+		return "", nil, err
+	}
+	return token, feed, nil
+}
+
+// DeleteFeed revokes the user's subscription URL
+func (s *CalendarService) DeleteFeed(userID uuid.UUID) error {
+	deleted, err := s.feedRepo.Delete(userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrCalendarFeedNotFound
+	}
+	return nil
+}
+
+func calendarEvent(event *models.Event) ical.Event {
+	entry := ical.Event{
+		UID:          event.ID.String() + "@playspotter",
+		Summary:      event.Title,
+		Start:        event.EventTime,
+		End:          event.EndTime(),
+		Status:       ical.StatusConfirmed,
+		Created:      event.CreatedAt,
+		LastModified: event.UpdatedAt,
+		HasGeo:       true,
+		Latitude:     event.Latitude,
+		Longitude:    event.Longitude,
+	}
+	if event.Status == models.EventStatusCancelled {
+		entry.Status = ical.StatusCancelled
+	}
+	if event.Description != nil {
+		entry.Description = *event.Description
+	}
+
+	var location []string
+	if event.LocationName != nil && *event.LocationName != "" {
+		location = append(location, *event.LocationName)
+	}
+	if event.Address != nil && *event.Address != "" {
+		location = append(location, *event.Address)
+	}
+	entry.Location = strings.Join(location, ", ")
+	return entry
+}
+
+func newFeedToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"strings"
+)
+
+// ErrCommentRejected is returned when a moderator refuses a comment
+var ErrCommentRejected = errors.New("comment was rejected by moderation")
+
+// CommentModerator reviews comments before they are stored. Returning an error
+// rejects the comment; moderators may also adjust it (e.g. mask words).
+type CommentModerator interface {
+	ReviewComment(comment *models.EventComment) error
+}
+
+// BlockedWordsModerator rejects comments containing any of the configured words
+type BlockedWordsModerator struct {
+	words []string
+}
+
+func NewBlockedWordsModerator(words []string) *BlockedWordsModerator {
+	normalized := make([]string, 0, len(words))
+	for _, w := range words {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			normalized = append(normalized, w)
+		}
+	}
+	return &BlockedWordsModerator{words: normalized}
+}
+
+func (m *BlockedWordsModerator) ReviewComment(comment *models.EventComment) error {
+	body := strings.ToLower(comment.Body)
+	for _, w := range m.words {
+		if strings.Contains(body, w) {
+			return ErrCommentRejected
+		}
+	}
+	return nil
+}
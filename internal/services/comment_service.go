@@ -0,0 +1,357 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/realtime"
+	"playspotter/internal/repositories"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrCommentNotFound is returned when a comment does not exist or is not visible
+var ErrCommentNotFound = errors.New("comment not found")
+
+const maxCommentLength = 2000
+
+type CommentService struct {
+	commentRepo     *repositories.CommentRepository
+	participantRepo *repositories.ParticipantRepository
+	eventService    *EventService
+	moderators      []CommentModerator
+}
+
+func NewCommentService(
+	commentRepo *repositories.CommentRepository,
+	participantRepo *repositories.ParticipantRepository,
+	eventService *EventService,
+	moderators ...CommentModerator,
+) *CommentService {
+	return &CommentService{
+		commentRepo:     commentRepo,
+		participantRepo: participantRepo,
+		eventService:    eventService,
+		moderators:      moderators,
+	}
+}
+
+// ListComments returns a page of discussion threads. userID is nil for anonymous
+// callers, who can only read discussions of public events.
+func (s *CommentService) ListComments(eventID uuid.UUID, userID *uuid.UUID, isAdmin bool, offset, limit int) ([]models.EventComment, int64, error) {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.canView(event, userID, isAdmin); err != nil {
+		return nil, 0, err
+	}
+
+	return s.commentRepo.ListThreads(eventID, isAdmin, offset, limit)
+}
+
+// CreateComment posts a comment or, with parentID, a reply. Replies to replies are
+// attached to the top-level comment so threads stay one level deep.
+func (s *CommentService) CreateComment(eventID, userID uuid.UUID, isAdmin bool, body string, parentID *uuid.UUID) (*models.EventComment, error) {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.canPost(event, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	comment := &models.EventComment{
+		EventID: eventID,
+		UserID:  userID,
+	}
+
+	if parentID != nil {
+		parent, err := s.commentRepo.FindByID(*parentID)
+		if err != nil || parent.EventID != eventID || parent.HiddenAt != nil {
+			return nil, ErrCommentNotFound
+		}
+		if parent.DeletedAt != nil {
+			return nil, errors.New("cannot reply to a deleted comment")
+		}
+		rootID := parent.ID
+		if parent.ParentID != nil {
+			rootID = *parent.ParentID
+		}
+		comment.ParentID = &rootID
+	}
+
+	if err := s.setBody(comment, body); err != nil {
+		return nil, err
+	}
+
+	if err := s.commentRepo.Create(comment); err != nil {
+		return nil, err
+	}
+
+	created, err := s.commentRepo.FindByID(comment.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(event, created, realtime.UpdateCommentPosted)
+	return created, nil
+}
+
+// UpdateComment lets authors edit their own comments
+func (s *CommentService) UpdateComment(eventID, commentID, userID uuid.UUID, body string) (*models.EventComment, error) {
+	comment, err := s.findComment(eventID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != userID {
+		return nil, errors.New("only the author can edit this comment")
+	}
+	if comment.DeletedAt != nil || comment.HiddenAt != nil {
+		return nil, errors.New("comment can no longer be edited")
+	}
+
+	if err := s.setBody(comment, body); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	comment.EditedAt = &now
+	if err := s.commentRepo.Update(comment); err != nil {
+		return nil, err
+	}
+	s.publishByID(comment, realtime.UpdateCommentEdited)
+	return comment, nil
+}
+
+// DeleteComment removes a comment for its author, the event organizers or admins.
+// The row is kept so replies remain attached.
+func (s *CommentService) DeleteComment(eventID, commentID, userID uuid.UUID, isAdmin bool) error {
+	comment, err := s.findComment(eventID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID {
+		event, err := s.eventService.GetEvent(eventID)
+		if err != nil {
+			return err
+		}
+		if err := s.eventService.authorize(event, userID, isAdmin, PermModerateComments); err != nil {
+			return err
+		}
+	}
+
+	if comment.DeletedAt != nil {
+		return errors.New("comment is already deleted")
+	}
+
+	now := time.Now().UTC()
+	comment.DeletedAt = &now
+	comment.DeletedBy = &userID
+	comment.Pinned = false
+	comment.PinnedAt = nil
+	comment.PinnedBy = nil
+	if err := s.commentRepo.Update(comment); err != nil {
+		return err
+	}
+	s.publishByID(comment, realtime.UpdateCommentDeleted)
+	return nil
+}
+
+// SetPinned pins or unpins a top-level comment (owner, co-host or admin)
+func (s *CommentService) SetPinned(eventID, commentID, userID uuid.UUID, isAdmin, pinned bool) error {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.eventService.authorize(event, userID, isAdmin, PermModerateComments); err != nil {
+		return err
+	}
+
+	comment, err := s.findComment(eventID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if pinned {
+		if comment.ParentID != nil {
+			return errors.New("only top-level comments can be pinned")
+		}
+		if comment.DeletedAt != nil || comment.HiddenAt != nil {
+			return errors.New("cannot pin a removed comment")
+		}
+		now := time.Now().UTC()
+		comment.Pinned = true
+		comment.PinnedAt = &now
+		comment.PinnedBy = &userID
+	} else {
+		comment.Pinned = false
+		comment.PinnedAt = nil
+		comment.PinnedBy = nil
+	}
+
+	return s.commentRepo.Update(comment)
+}
+
+// ListForModeration returns comments across events for the admin moderation view
+func (s *CommentService) ListForModeration(filter repositories.CommentFilter) ([]models.EventComment, int64, error) {
+	return s.commentRepo.List(filter)
+}
+
+// HideComment hides a comment from everyone but admins
+func (s *CommentService) HideComment(commentID, adminID uuid.UUID, reason string) error {
+	comment, err := s.commentRepo.FindByID(commentID)
+	if err != nil {
+		return ErrCommentNotFound
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("a reason is required")
+	}
+	if comment.HiddenAt != nil {
+		return errors.New("comment is already hidden")
+	}
+
+	now := time.Now().UTC()
+	comment.HiddenAt = &now
+	comment.HiddenBy = &adminID
+	comment.HiddenReason = &reason
+	comment.Pinned = false
+	comment.PinnedAt = nil
+	comment.PinnedBy = nil
+	if err := s.commentRepo.Update(comment); err != nil {
+		return err
+	}
+	// To everyone else a hidden comment is gone
+	s.publishByID(comment, realtime.UpdateCommentDeleted)
+	return nil
+}
+
+// UnhideComment restores a hidden comment
+func (s *CommentService) UnhideComment(commentID uuid.UUID) error {
+	comment, err := s.commentRepo.FindByID(commentID)
+	if err != nil {
+		return ErrCommentNotFound
+	}
+
+	if comment.HiddenAt == nil {
+		return errors.New("comment is not hidden")
+	}
+
+	comment.HiddenAt = nil
+	comment.HiddenBy = nil
+	comment.HiddenReason = nil
+	return s.commentRepo.Update(comment)
+}
+
+// CanView reports whether the caller may read the event's discussion
+func (s *CommentService) CanView(event *models.Event, userID *uuid.UUID, isAdmin bool) bool {
+	return s.canView(event, userID, isAdmin) == nil
+}
+
+// publish sends a comment change to the event's realtime subscribers; deletions
+// carry only the comment's identity
+func (s *CommentService) publish(event *models.Event, comment *models.EventComment, updateType string) {
+	data := map[string]interface{}{
+		"comment_id": comment.ID,
+		"parent_id":  comment.ParentID,
+		"user_id":    comment.UserID,
+	}
+	if updateType != realtime.UpdateCommentDeleted {
+		data["body"] = comment.Body
+		if comment.User != nil {
+			data["author_name"] = comment.User.Name
+		}
+	}
+	s.eventService.publish(event, updateType, data)
+}
+
+func (s *CommentService) publishByID(comment *models.EventComment, updateType string) {
+	event, err := s.eventService.GetEvent(comment.EventID)
+	if err != nil {
+		return
+	}
+	s.publish(event, comment, updateType)
+}
+
+func (s *CommentService) findComment(eventID, commentID uuid.UUID) (*models.EventComment, error) {
+	comment, err := s.commentRepo.FindByID(commentID)
+	if err != nil || comment.EventID != eventID {
+		return nil, ErrCommentNotFound
+	}
+	return comment, nil
+}
+
+// setBody validates the text and runs it past the configured moderators
+func (s *CommentService) setBody(comment *models.EventComment, body string) error {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return errors.New("comment cannot be empty")
+	}
+	if len([]rune(body)) > maxCommentLength {
+		return errors.New("comment is too long")
+	}
+
+	comment.Body = body
+	for _, m := range s.moderators {
+		if err := m.ReviewComment(comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// canView allows everyone on public events; participants-only discussions are
+// limited to joined participants and organizers. Events the caller cannot see
+// (drafts, hidden events) are reported as not found, as ViewEvent does.
+func (s *CommentService) canView(event *models.Event, userID *uuid.UUID, isAdmin bool) error {
+	if !s.eventService.canSee(event, userID, isAdmin) {
+		return ErrEventNotFound
+	}
+	if event.Visibility != models.EventVisibilityParticipants || isAdmin {
+		return nil
+	}
+	if userID == nil {
+		return ErrEventForbidden
+	}
+	return s.isInsider(event, *userID)
+}
+
+// canPost requires a signed-in user who can view the discussion and is not banned
+func (s *CommentService) canPost(event *models.Event, userID uuid.UUID, isAdmin bool) error {
+	if isAdmin {
+		return nil
+	}
+
+	participant, err := s.participantRepo.Find(event.ID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if participant != nil && participant.Status == models.ParticipantStatusBanned {
+		return errors.New("you have been banned from this event")
+	}
+
+	return s.canView(event, &userID, false)
+}
+
+func (s *CommentService) isInsider(event *models.Event, userID uuid.UUID) error {
+	if s.eventService.authorize(event, userID, false, PermModerateComments) == nil {
+		return nil
+	}
+
+	joined, err := s.participantRepo.Exists(event.ID, userID)
+	if err != nil {
+		return err
+	}
+	if !joined {
+		return ErrEventForbidden
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrCostSplitNotFound is returned when an event has no cost split yet
+var ErrCostSplitNotFound = errors.New("cost split not found")
+
+// costReminderCooldown limits how often the same participant is reminded
+const costReminderCooldown = 24 * time.Hour
+
+type CostService struct {
+	costRepo            *repositories.CostRepository
+	participantRepo     *repositories.ParticipantRepository
+	eventService        *EventService
+	notificationService *NotificationService
+}
+
+func NewCostService(
+	costRepo *repositories.CostRepository,
+	participantRepo *repositories.ParticipantRepository,
+	eventService *EventService,
+	notificationService *NotificationService,
+) *CostService {
+	return &CostService{
+		costRepo:            costRepo,
+		participantRepo:     participantRepo,
+		eventService:        eventService,
+		notificationService: notificationService,
+	}
+}
+
+// SplitCostsInput is the organizer's cost entry. Exempt participants pay nothing,
+// FixedCents pins an exact amount and Weights skew the split of the rest.
+type SplitCostsInput struct {
+	TotalCents int
+	Currency   string
+	Note       *string
+	Exempt     []uuid.UUID
+	FixedCents map[uuid.UUID]int
+	Weights    map[uuid.UUID]float64
+}
+
+// GetSplit returns the event's cost split to organizers and participants
+func (s *CostService) GetSplit(eventID, userID uuid.UUID, isAdmin bool) (*models.EventCostSplit, error) {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventService.authorize(event, userID, isAdmin, PermManageParticipants); err != nil {
+		joined, err := s.participantRepo.Exists(eventID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !joined {
+			return nil, ErrEventForbidden
+		}
+	}
+
+	split, err := s.costRepo.FindByEvent(eventID)
+	if err != nil {
+		return nil, ErrCostSplitNotFound
+	}
+	return split, nil
+}
+
+// SplitCosts divides the total court cost among checked-in participants, replacing an
+// earlier split as long as nobody has settled yet
+func (s *CostService) SplitCosts(eventID, userID uuid.UUID, isAdmin bool, input SplitCostsInput) (*models.EventCostSplit, error) {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventService.authorize(event, userID, isAdmin, PermManageParticipants); err != nil {
+		return nil, err
+	}
+
+	if event.Status == models.EventStatusCancelled {
+		return nil, errors.New("cannot split costs for a cancelled event")
+	}
+	if event.PriceCents > 0 {
+		return nil, errors.New("event was paid upfront")
+	}
+	if event.Status != models.EventStatusOngoing && event.Status != models.EventStatusCompleted {
+		return nil, errors.New("costs can only be split once the event has started")
+	}
+
+	if existing, err := s.costRepo.FindByEvent(eventID); err == nil {
+		for _, share := range existing.Shares {
+			if share.SettledAt != nil {
+				return nil, errors.New("cannot replace a split after payments were settled")
+			}
+		}
+	}
+
+	participants, err := s.participantRepo.ListByEvent(eventID, models.ParticipantStatusJoined)
+	if err != nil {
+		return nil, err
+	}
+
+	checkedIn := make(map[uuid.UUID]bool)
+	var inputs []CostShareInput
+	for _, p := range participants {
+		if p.CheckedInAt == nil {
+			continue
+		}
+		checkedIn[p.UserID] = true
+		inputs = append(inputs, CostShareInput{UserID: p.UserID, Weight: input.Weights[p.UserID]})
+	}
+	if len(inputs) == 0 {
+		return nil, errors.New("no participants have checked in")
+	}
+
+	exempt := make(map[uuid.UUID]bool, len(input.Exempt))
+	for _, id := range input.Exempt {
+		exempt[id] = true
+	}
+	for id := range exempt {
+		if !checkedIn[id] {
+			return nil, errors.New("exemptions must reference checked-in participants")
+		}
+	}
+	for id := range input.FixedCents {
+		if !checkedIn[id] {
+			return nil, errors.New("fixed amounts must reference checked-in participants")
+		}
+	}
+	for id := range input.Weights {
+		if !checkedIn[id] {
+			return nil, errors.New("weights must reference checked-in participants")
+		}
+	}
+
+	for i := range inputs {
+		id := inputs[i].UserID
+		inputs[i].Exempt = exempt[id]
+		if fixed, ok := input.FixedCents[id]; ok {
+			inputs[i].FixedCents = &fixed
+		}
+	}
+
+	amounts, err := SplitCost(input.TotalCents, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reuse the event pricing rules for the currency code
+	priced := &models.Event{Currency: event.Currency}
+	if err := normalizePricing(priced, 0, input.Currency); err != nil {
+		return nil, err
+	}
+
+	split := &models.EventCostSplit{
+		EventID:    eventID,
+		TotalCents: input.TotalCents,
+		Currency:   priced.Currency,
+		Note:       input.Note,
+		CreatedBy:  userID,
+	}
+	for i, in := range inputs {
+		split.Shares = append(split.Shares, models.EventCostShare{
+			EventID:     eventID,
+			UserID:      in.UserID,
+			AmountCents: amounts[i],
+			Exempt:      in.Exempt,
+		})
+	}
+
+	if err := s.costRepo.Replace(split); err != nil {
+		return nil, err
+	}
+	return s.costRepo.FindByEvent(eventID)
+}
+
+func (s *CostService) DeleteSplit(eventID, userID uuid.UUID, isAdmin bool) error {
+	split, err := s.organizerSplit(eventID, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+
+	for _, share := range split.Shares {
+		if share.SettledAt != nil {
+			return errors.New("cannot delete a split after payments were settled")
+		}
+	}
+
+	return s.costRepo.DeleteByEvent(eventID)
+}
+
+// SetSettled marks a participant's share as paid (or reverts it)
+func (s *CostService) SetSettled(eventID, targetID, userID uuid.UUID, isAdmin, settled bool) error {
+	if _, err := s.organizerSplit(eventID, userID, isAdmin); err != nil {
+		return err
+	}
+
+	share, err := s.costRepo.FindShare(eventID, targetID)
+	if err != nil {
+		return errors.New("user has no share in this cost split")
+	}
+
+	if settled {
+		if share.Exempt || share.AmountCents == 0 {
+			return errors.New("user has nothing to pay")
+		}
+		if share.SettledAt != nil {
+			return errors.New("share is already settled")
+		}
+		now := time.Now().UTC()
+		share.SettledAt = &now
+		share.SettledBy = &userID
+	} else {
+		if share.SettledAt == nil {
+			return errors.New("share is not settled")
+		}
+		share.SettledAt = nil
+		share.SettledBy = nil
+	}
+
+	return s.costRepo.UpdateShare(share)
+}
+
+// SendReminders nudges participants who have not settled yet, skipping anyone
+// reminded within the cooldown. It returns the shares that were reminded.
+func (s *CostService) SendReminders(eventID, userID uuid.UUID, isAdmin bool) ([]models.EventCostShare, error) {
+	split, err := s.organizerSplit(eventID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var reminded []models.EventCostShare
+	for _, share := range split.Shares {
+		if !share.Outstanding() {
+			continue
+		}
+		if share.LastRemindedAt != nil && now.Sub(*share.LastRemindedAt) < costReminderCooldown {
+			continue
+		}
+
+		share.LastRemindedAt = &now
+		share.ReminderCount++
+		if err := s.costRepo.UpdateShare(&share); err != nil {
+			return nil, err
+		}
+		reminded = append(reminded, share)
+		s.notifyReminder(split, &share)
+	}
+
+	return reminded, nil
+}
+
+// ListOutstanding returns what the user still owes across events
+func (s *CostService) ListOutstanding(userID uuid.UUID) ([]models.EventCostShare, error) {
+	return s.costRepo.ListOutstandingByUser(userID)
+}
+
+// notifyReminder tells the participant what they still owe; a failed notification
+// does not undo the reminder
+func (s *CostService) notifyReminder(split *models.EventCostSplit, share *models.EventCostShare) {
+	title := "the event"
+	if event, err := s.eventService.GetEvent(split.EventID); err == nil {
+		title = event.Title
+	}
+
+	err := s.notificationService.Notify([]uuid.UUID{share.UserID}, NotificationInput{
+		Type:    models.NotificationCostReminder,
+		EventID: &split.EventID,
+		Title:   "Payment reminder",
+		Body:    fmt.Sprintf("You still owe %s for %s.", formatCents(share.AmountCents, split.Currency), title),
+		Data: map[string]interface{}{
+			"amount_cents": share.AmountCents,
+			"currency":     split.Currency,
+		},
+	})
+	if err != nil {
+		log.Printf("notifications: failed to send cost reminder for event %s: %v", split.EventID, err)
+	}
+}
+
+// formatCents renders an amount in minor units, e.g. "12.50 EUR"
+func formatCents(cents int, currency string) string {
+	return fmt.Sprintf("%d.%02d %s", cents/100, cents%100, currency)
+}
+
+func (s *CostService) organizerSplit(eventID, userID uuid.UUID, isAdmin bool) (*models.EventCostSplit, error) {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventService.authorize(event, userID, isAdmin, PermManageParticipants); err != nil {
+		return nil, err
+	}
+
+	split, err := s.costRepo.FindByEvent(eventID)
+	if err != nil {
+		return nil, ErrCostSplitNotFound
+	}
+	return split, nil
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// CostShareInput describes how one participant takes part in a cost split. Exempt
+// participants pay nothing, FixedCents pins an exact amount and Weight (default 1)
+// scales the share of whatever remains.
+type CostShareInput struct {
+	UserID     uuid.UUID
+	Exempt     bool
+	FixedCents *int
+	Weight     float64
+}
+
+// SplitCost divides totalCents between the inputs and returns the amount owed by each,
+// in input order. Leftover cents from rounding go to the largest remainders so the
+// amounts always add up to the total.
+func SplitCost(totalCents int, inputs []CostShareInput) ([]int, error) {
+	if totalCents <= 0 {
+		return nil, errors.New("total cost must be greater than zero")
+	}
+
+	amounts := make([]int, len(inputs))
+	remaining := totalCents
+	totalWeight := 0.0
+	var weighted []int
+
+	for i, in := range inputs {
+		switch {
+		case in.Exempt:
+			continue
+		case in.FixedCents != nil:
+			if *in.FixedCents < 0 {
+				return nil, errors.New("fixed amounts cannot be negative")
+			}
+			amounts[i] = *in.FixedCents
+			remaining -= *in.FixedCents
+		default:
+			if in.Weight < 0 {
+				return nil, errors.New("weights cannot be negative")
+			}
+			weighted = append(weighted, i)
+			totalWeight += weightOf(in)
+		}
+	}
+
+	if remaining < 0 {
+		return nil, errors.New("fixed amounts exceed the total cost")
+	}
+	if remaining == 0 {
+		return amounts, nil
+	}
+	if len(weighted) == 0 || totalWeight == 0 {
+		return nil, errors.New("no participants left to share the remaining cost")
+	}
+
+	type fraction struct {
+		index int
+		rest  float64
+	}
+	fractions := make([]fraction, 0, len(weighted))
+	assigned := 0
+	for _, i := range weighted {
+		exact := float64(remaining) * weightOf(inputs[i]) / totalWeight
+		cents := int(exact)
+		amounts[i] = cents
+		assigned += cents
+		fractions = append(fractions, fraction{index: i, rest: exact - float64(cents)})
+	}
+
+	sort.SliceStable(fractions, func(a, b int) bool { return fractions[a].rest > fractions[b].rest })
+	for k := 0; k < remaining-assigned; k++ {
+		amounts[fractions[k%len(fractions)].index]++
+	}
+
+	return amounts, nil
+}
+
+func weightOf(in CostShareInput) float64 {
+	if in.Weight == 0 {
+		return 1
+	}
+	return in.Weight
+}
@@ -0,0 +1,89 @@
+package services_test
+
+import (
+	"playspotter/internal/services"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func sumCents(amounts []int) int {
+	total := 0
+	for _, a := range amounts {
+		total += a
+	}
+	return total
+}
+
+func TestSplitCost(t *testing.T) {
+	players := func(n int) []services.CostShareInput {
+		inputs := make([]services.CostShareInput, n)
+		for i := range inputs {
+			inputs[i] = services.CostShareInput{UserID: uuid.New()}
+		}
+		return inputs
+	}
+
+	t.Run("Even split distributes leftover cents", func(t *testing.T) {
+		amounts, err := services.SplitCost(1000, players(3))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if sumCents(amounts) != 1000 {
+			t.Errorf("Expected amounts to add up to 1000, got %d", sumCents(amounts))
+		}
+		for _, a := range amounts {
+			if a != 333 && a != 334 {
+				t.Errorf("Expected 333 or 334, got %d", a)
+			}
+		}
+	})
+
+	t.Run("Exempt and fixed shares", func(t *testing.T) {
+		fixed := 200
+		inputs := players(4)
+		inputs[0].Exempt = true
+		inputs[1].FixedCents = &fixed
+
+		amounts, err := services.SplitCost(1000, inputs)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if amounts[0] != 0 || amounts[1] != 200 || amounts[2] != 400 || amounts[3] != 400 {
+			t.Errorf("Unexpected amounts %v", amounts)
+		}
+	})
+
+	t.Run("Weighted split", func(t *testing.T) {
+		inputs := players(2)
+		inputs[0].Weight = 2
+
+		amounts, err := services.SplitCost(900, inputs)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if amounts[0] != 600 || amounts[1] != 300 {
+			t.Errorf("Expected [600 300], got %v", amounts)
+		}
+	})
+
+	t.Run("Fixed amounts over total", func(t *testing.T) {
+		fixed := 1200
+		inputs := players(2)
+		inputs[0].FixedCents = &fixed
+
+		if _, err := services.SplitCost(1000, inputs); err == nil {
+			t.Error("Expected an error when fixed amounts exceed the total")
+		}
+	})
+
+	t.Run("Everyone exempt", func(t *testing.T) {
+		inputs := players(2)
+		inputs[0].Exempt = true
+		inputs[1].Exempt = true
+
+		if _, err := services.SplitCost(1000, inputs); err == nil {
+			t.Error("Expected an error when nobody can pay")
+		}
+	})
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"playspotter/internal/jobs"
+	"playspotter/internal/models"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// JobEventRefund refunds the paid participants of a cancelled event
+const JobEventRefund = "event_refund"
+
+// ErrCancellationWindow is returned when an organizer tries to cancel an event that
+// starts within the cancellation window; only admins can cancel it then
+var ErrCancellationWindow = errors.New("event starts too soon to be cancelled; contact an admin")
+
+// withinCancellationWindow reports whether the event starts within the window.
+// Drafts have nobody to let down and can always be cancelled.
+func (s *EventService) withinCancellationWindow(event *models.Event) bool {
+	if s.cancellationWindow <= 0 || event.Status == models.EventStatusDraft {
+		return false
+	}
+	return time.Now().UTC().After(event.EventTime.Add(-s.cancellationWindow))
+}
+
+// cancel records who cancelled the event and why, tells the participants and
+// queues the refunds of paid spots
+func (s *EventService) cancel(event *models.Event, actorID uuid.UUID, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("cancellation reason is required")
+	}
+	if len(reason) > 500 {
+		return errors.New("cancellation reason must be at most 500 characters")
+	}
+	if event.Status == models.EventStatusCancelled {
+		return errors.New("event is already cancelled")
+	}
+
+	now := time.Now().UTC()
+	event.Status = models.EventStatusCancelled
+	event.CancellationReason = &reason
+	event.CancelledAt = &now
+	event.CancelledBy = &actorID
+	if err := s.eventRepo.Update(event); err != nil {
+		return err
+	}
+	s.publishStatus(event)
+	s.notifyCancelled(event, actorID)
+
+	// Paid participants get their money back in the background, so a failing refund
+	// is retried instead of failing a cancellation that already happened
+	s.scheduleRefunds(event)
+	return nil
+}
+
+// scheduleRefunds queues the refunds of a cancelled event, refunding right away
+// when the job cannot be queued
+func (s *EventService) scheduleRefunds(event *models.Event) {
+	if s.jobRepo != nil {
+		key := fmt.Sprintf("%s:%s:%d", JobEventRefund, event.ID, event.CancelledAt.Unix())
+		job := &models.Job{
+			Type:        JobEventRefund,
+			Payload:     map[string]interface{}{"event_id": event.ID.String()},
+			UniqueKey:   &key,
+			RunAt:       time.Now().UTC(),
+			MaxAttempts: 8,
+		}
+		err := s.jobRepo.Enqueue(job)
+		if err == nil {
+			return
+		}
+		log.Printf("jobs: failed to schedule refunds for event %s, refunding now: %v", event.ID, err)
+	}
+
+	if err := s.paymentService.RefundEvent(event.ID); err != nil {
+		log.Printf("payments: failed to refund cancelled event %s: %v", event.ID, err)
+	}
+}
+
+// RefundCancelledEvent handles JobEventRefund jobs. A failed refund fails the job so
+// it is retried with backoff; payments left refund_failed after the last attempt
+// need a manual refund. Events that were reinstated in the meantime keep their payments.
+func (s *EventService) RefundCancelledEvent(ctx context.Context, job *models.Job) error {
+	eventIDValue, _ := job.Payload["event_id"].(string)
+	eventID, err := uuid.Parse(eventIDValue)
+	if err != nil {
+		return fmt.Errorf("%w: invalid event_id", jobs.ErrPermanent)
+	}
+
+	event, err := s.eventRepo.FindByID(eventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if event.Status != models.EventStatusCancelled {
+		return nil
+	}
+	return s.paymentService.RefundEvent(event.ID)
+}
+
+// UncancelEvent reinstates a cancelled event (admin only). The event must still be
+// in the future. Participants whose payments were refunded lose their spot and are
+// asked to join again; everyone else keeps theirs, as long as they fit.
+func (s *EventService) UncancelEvent(id, adminID uuid.UUID) (*models.Event, error) {
+	event, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrEventNotFound
+	}
+
+	if event.Status != models.EventStatusCancelled {
+		return nil, errors.New("event is not cancelled")
+	}
+	if !event.EventTime.After(time.Now().UTC()) {
+		return nil, errors.New("cannot reinstate an event that has already started")
+	}
+
+	released, err := s.paymentService.RefundedUserIDs(event.ID)
+	if err != nil {
+		return nil, err
+	}
+	var releasing []uuid.UUID
+	for _, userID := range released {
+		participant, err := s.participantRepo.Find(event.ID, userID)
+		if err == nil && participant.Status == models.ParticipantStatusJoined {
+			releasing = append(releasing, userID)
+		}
+	}
+
+	count, err := s.participantRepo.CountByEvent(event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if remaining := int(count) - len(releasing); remaining > event.Capacity {
+		return nil, fmt.Errorf("event has %d participants but only %d spots", remaining, event.Capacity)
+	}
+
+	for _, userID := range releasing {
+		if err := s.participantRepo.Delete(event.ID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Events cancelled before they were published go back to being drafts
+	event.Status = models.EventStatusOpen
+	if event.PublishedAt == nil {
+		event.Status = models.EventStatusDraft
+	}
+	event.CancellationReason = nil
+	event.CancelledAt = nil
+	event.CancelledBy = nil
+	if err := s.eventRepo.Update(event); err != nil {
+		return nil, err
+	}
+
+	if event.Status == models.EventStatusDraft {
+		if event.PublishAt != nil && event.PublishAt.After(time.Now().UTC()) {
+			s.schedulePublish(event)
+		}
+		return event, nil
+	}
+
+	s.publishStatus(event)
+	if err := s.refreshCapacityStatus(event); err != nil {
+		log.Printf("events: failed to refresh status of reinstated event %s: %v", event.ID, err)
+	}
+	s.scheduleReminders(event)
+	s.notifyReinstated(event, adminID, releasing)
+	return event, nil
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"log"
+	"playspotter/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventCopyOptions are what changes when an event is created from an existing one
+// or from a template
+type EventCopyOptions struct {
+	EventTime time.Time
+	// EventTimeLocal means EventTime has no offset and is read in the event's zone
+	EventTimeLocal bool
+	// Title replaces the copied title when set
+	Title     string
+	Draft     bool
+	PublishAt *time.Time
+}
+
+func (o EventCopyOptions) apply(event *models.Event) {
+	if o.EventTimeLocal {
+		event.LocalEventTime = o.EventTime
+	} else {
+		event.EventTime = o.EventTime.UTC()
+	}
+	if o.Title != "" {
+		event.Title = o.Title
+	}
+	if o.Draft {
+		event.Status = models.EventStatusDraft
+	}
+	event.PublishAt = o.PublishAt
+}
+
+// CloneEvent creates a new event with the details of an existing one at a new time.
+// The caller becomes the owner and the source's co-hosts are carried over.
+func (s *EventService) CloneEvent(sourceID, userID uuid.UUID, isAdmin bool, opts EventCopyOptions) (*models.Event, error) {
+	source, err := s.eventRepo.FindByID(sourceID)
+	if err != nil {
+		return nil, ErrEventNotFound
+	}
+
+	if err := s.authorize(source, userID, isAdmin, PermEditEvent); err != nil {
+		return nil, err
+	}
+
+	event := &models.Event{
+		CreatorID:    userID,
+		Title:        source.Title,
+		SportType:    source.SportType,
+		DurationMin:  source.DurationMin,
+		TimeZone:     source.TimeZone,
+		VenueID:      source.VenueID,
+		LocationName: source.LocationName,
+		Address:      source.Address,
+		Latitude:     source.Latitude,
+		Longitude:    source.Longitude,
+		Capacity:     source.Capacity,
+		Description:  source.Description,
+		PriceCents:   source.PriceCents,
+		Currency:     source.Currency,
+		Visibility:   source.Visibility,
+	}
+	opts.apply(event)
+
+	if err := s.CreateEvent(event); err != nil {
+		return nil, err
+	}
+
+	for _, cohost := range source.CoHosts {
+		if cohost.UserID == userID {
+			continue
+		}
+		err := s.cohostRepo.Create(&models.EventCoHost{
+			EventID: event.ID,
+			UserID:  cohost.UserID,
+			AddedBy: &userID,
+		})
+		if err != nil {
+			log.Printf("events: failed to copy co-host %s to event %s: %v", cohost.UserID, event.ID, err)
+		}
+	}
+
+	return s.eventRepo.FindByID(event.ID)
+}
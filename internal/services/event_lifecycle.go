@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"log"
+	"playspotter/internal/models"
+	"time"
+)
+
+// JobEventLifecycle periodically marks started events ongoing and ended ones completed;
+// drafts keep their status (see EventRepository.AdvanceStatuses)
+const JobEventLifecycle = "event_lifecycle"
+
+// AdvanceLifecycle handles JobEventLifecycle jobs and announces each status change
+func (s *EventService) AdvanceLifecycle(ctx context.Context, job *models.Job) error {
+	events, err := s.eventRepo.AdvanceStatuses(time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	for i := range events {
+		s.publishStatus(&events[i])
+	}
+	if len(events) > 0 {
+		log.Printf("jobs: advanced the status of %d events", len(events))
+	}
+	return nil
+}
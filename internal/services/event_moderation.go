@@ -0,0 +1,110 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"playspotter/internal/models"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HideEvent takes an event out of the feed and keeps everyone but its organizers
+// and admins from viewing or joining it (admin only). Participants keep their spots.
+func (s *EventService) HideEvent(id, adminID uuid.UUID, reason string) (*models.Event, error) {
+	event, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrEventNotFound
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New("a reason is required")
+	}
+	if len(reason) > 500 {
+		return nil, errors.New("reason must be at most 500 characters")
+	}
+	if event.HiddenAt != nil {
+		return nil, errors.New("event is already hidden")
+	}
+
+	now := time.Now().UTC()
+	event.HiddenAt = &now
+	event.HiddenBy = &adminID
+	event.HiddenReason = &reason
+	if err := s.eventRepo.Update(event); err != nil {
+		return nil, err
+	}
+
+	s.notify(event, s.organizerIDs(event), adminID, NotificationInput{
+		Type:  models.NotificationModeration,
+		Title: "Event hidden",
+		Body:  fmt.Sprintf("%s was hidden by a moderator: %s", event.Title, reason),
+		Data: map[string]interface{}{
+			"action": models.ModerationActionHideEvent,
+			"reason": reason,
+		},
+	})
+	return event, nil
+}
+
+// UnhideEvent puts a hidden event back in the feed (admin only)
+func (s *EventService) UnhideEvent(id uuid.UUID) (*models.Event, error) {
+	event, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrEventNotFound
+	}
+
+	if event.HiddenAt == nil {
+		return nil, errors.New("event is not hidden")
+	}
+
+	event.HiddenAt = nil
+	event.HiddenBy = nil
+	event.HiddenReason = nil
+	if err := s.eventRepo.Update(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ReleaseOrganizedEvents deals with the upcoming events of a user who was suspended
+// or banned, up to before when given. Each event goes to its longest-standing co-host
+// whose account is in good standing; events without one are cancelled with the reason.
+func (s *EventService) ReleaseOrganizedEvents(userID, adminID uuid.UUID, before *time.Time, reason string) error {
+	events, err := s.eventRepo.ListUpcomingByCreator(userID, before)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for i := range events {
+		event := &events[i]
+
+		cohosts, err := s.cohostRepo.ListByEvent(event.ID)
+		if err != nil {
+			return err
+		}
+		transferred := false
+		for _, cohost := range cohosts {
+			if cohost.User == nil || CheckAccountStatus(cohost.User, now) != nil {
+				continue
+			}
+			if err := s.eventRepo.TransferOwnership(event, cohost.UserID, false); err != nil {
+				return err
+			}
+			transferred = true
+			break
+		}
+		if transferred {
+			continue
+		}
+
+		if err := s.cancel(event, adminID, reason); err != nil {
+			log.Printf("events: failed to cancel event %s of restricted user %s: %v", event.ID, userID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/timezone"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// notify delivers a notification about the event. Failures are logged rather than
+// returned so they never undo the change being announced.
+func (s *EventService) notify(event *models.Event, userIDs []uuid.UUID, actorID uuid.UUID, input NotificationInput) {
+	if s.notificationService == nil {
+		return
+	}
+
+	recipients := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != actorID {
+			recipients = append(recipients, id)
+		}
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	input.EventID = &event.ID
+	if err := s.notificationService.Notify(recipients, input); err != nil {
+		log.Printf("notifications: failed to send %s for event %s: %v", input.Type, event.ID, err)
+	}
+}
+
+// participantIDs returns everyone holding a spot, including unpaid reservations
+func (s *EventService) participantIDs(event *models.Event) []uuid.UUID {
+	participants, err := s.participantRepo.ListByEvent(event.ID, models.ParticipantStatusJoined, models.ParticipantStatusPendingPayment)
+	if err != nil {
+		log.Printf("notifications: failed to list participants of event %s: %v", event.ID, err)
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(participants))
+	for _, p := range participants {
+		ids = append(ids, p.UserID)
+	}
+	return ids
+}
+
+// organizerIDs returns the owner and co-hosts of the event
+func (s *EventService) organizerIDs(event *models.Event) []uuid.UUID {
+	ids := []uuid.UUID{event.CreatorID}
+
+	cohosts, err := s.cohostRepo.ListByEvent(event.ID)
+	if err != nil {
+		log.Printf("notifications: failed to list co-hosts of event %s: %v", event.ID, err)
+		return ids
+	}
+	for _, c := range cohosts {
+		ids = append(ids, c.UserID)
+	}
+	return ids
+}
+
+func (s *EventService) notifyCancelled(event *models.Event, actorID uuid.UUID) {
+	s.notify(event, s.participantIDs(event), actorID, NotificationInput{
+		Type:  models.NotificationEventCancelled,
+		Title: "Event cancelled",
+		Body:  fmt.Sprintf("%s on %s has been cancelled%s", event.Title, formatEventTime(event), cancellationReason(event)),
+		Data: map[string]interface{}{
+			"cancellation_reason": event.CancellationReason,
+		},
+	})
+}
+
+func cancellationReason(event *models.Event) string {
+	if event.CancellationReason == nil {
+		return "."
+	}
+	return ": " + *event.CancellationReason
+}
+
+// notifyReinstated tells the participants that the event is back on. Those whose
+// payments were refunded no longer hold a spot and have to join again.
+func (s *EventService) notifyReinstated(event *models.Event, actorID uuid.UUID, released []uuid.UUID) {
+	s.notify(event, s.participantIDs(event), actorID, NotificationInput{
+		Type:  models.NotificationEventReinstated,
+		Title: "Event back on",
+		Body:  fmt.Sprintf("%s on %s is taking place after all.", event.Title, formatEventTime(event)),
+	})
+	s.notify(event, released, actorID, NotificationInput{
+		Type:  models.NotificationEventReinstated,
+		Title: "Event back on",
+		Body:  fmt.Sprintf("%s on %s is taking place after all. Your payment was refunded, so join again to get a spot.", event.Title, formatEventTime(event)),
+	})
+}
+
+func (s *EventService) notifyRescheduled(event *models.Event, actorID uuid.UUID) {
+	place := ""
+	if event.LocationName != nil && *event.LocationName != "" {
+		place = " at " + *event.LocationName
+	}
+	s.notify(event, s.participantIDs(event), actorID, NotificationInput{
+		Type:  models.NotificationEventRescheduled,
+		Title: "Event rescheduled",
+		Body:  fmt.Sprintf("%s now takes place on %s%s.", event.Title, formatEventTime(event), place),
+		Data: map[string]interface{}{
+			"event_time": event.EventTime,
+			"latitude":   event.Latitude,
+			"longitude":  event.Longitude,
+		},
+	})
+}
+
+func (s *EventService) notifyFull(event *models.Event) {
+	s.notify(event, s.organizerIDs(event), uuid.Nil, NotificationInput{
+		Type:  models.NotificationEventFull,
+		Title: "Event is full",
+		Body:  fmt.Sprintf("All %d spots of %s are taken.", event.Capacity, event.Title),
+	})
+}
+
+func (s *EventService) notifyJoined(event *models.Event, userID uuid.UUID) {
+	name := "Someone"
+	if user, err := s.userRepo.FindByID(userID); err == nil {
+		name = user.Name
+	}
+	s.notify(event, s.organizerIDs(event), userID, NotificationInput{
+		Type:  models.NotificationParticipantJoined,
+		Title: "New participant",
+		Body:  fmt.Sprintf("%s joined %s.", name, event.Title),
+		Data:  map[string]interface{}{"user_id": userID},
+	})
+}
+
+func (s *EventService) notifyRemoved(event *models.Event, participant *models.EventParticipant, actorID uuid.UUID) {
+	verb := "removed from"
+	if participant.Status == models.ParticipantStatusBanned {
+		verb = "banned from"
+	}
+	body := fmt.Sprintf("You have been %s %s.", verb, event.Title)
+	if participant.RemovalReason != nil {
+		body = fmt.Sprintf("You have been %s %s: %s", verb, event.Title, *participant.RemovalReason)
+	}
+	s.notify(event, []uuid.UUID{participant.UserID}, actorID, NotificationInput{
+		Type:  models.NotificationParticipantRemoved,
+		Title: "Removed from event",
+		Body:  body,
+		Data:  map[string]interface{}{"status": participant.Status},
+	})
+}
+
+// formatEventTime shows the start time as the players on site read it
+func formatEventTime(event *models.Event) string {
+	loc, err := timezone.Load(event.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return event.EventTime.In(loc).Format("Mon 2 Jan 2006 15:04 MST")
+}
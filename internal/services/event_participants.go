@@ -0,0 +1,232 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/realtime"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ListParticipants returns the event roster of an event the caller can view. Organizers see every record including
+// removed and banned users; everyone else sees only active participants.
+func (s *EventService) ListParticipants(eventID, userID uuid.UUID, isAdmin bool) ([]models.EventParticipant, bool, error) {
+	event, err := s.ViewEvent(eventID, &userID, isAdmin)
+	if err != nil {
+		return nil, false, err
+	}
+
+	isOrganizer := s.authorize(event, userID, isAdmin, PermManageParticipants) == nil
+	if isOrganizer {
+		participants, err := s.participantRepo.ListByEvent(eventID)
+		return participants, true, err
+	}
+
+	participants, err := s.participantRepo.ListByEvent(eventID, models.ParticipantStatusJoined)
+	return participants, false, err
+}
+
+// GetParticipation returns the caller's own participation record, including any removal reason
+func (s *EventService) GetParticipation(eventID, userID uuid.UUID) (*models.EventParticipant, error) {
+	return s.participantRepo.Find(eventID, userID)
+}
+
+// RemoveParticipant kicks a participant; they may join again later
+func (s *EventService) RemoveParticipant(eventID, targetID, userID uuid.UUID, isAdmin bool, reason string) error {
+	return s.setParticipantStatus(eventID, targetID, userID, isAdmin, models.ParticipantStatusRemoved, reason)
+}
+
+// BanParticipant removes a participant (or pre-emptively blocks a user) and prevents them from rejoining
+func (s *EventService) BanParticipant(eventID, targetID, userID uuid.UUID, isAdmin bool, reason string) error {
+	return s.setParticipantStatus(eventID, targetID, userID, isAdmin, models.ParticipantStatusBanned, reason)
+}
+
+// UnbanParticipant lifts an event ban; the user is not re-added but may join again
+func (s *EventService) UnbanParticipant(eventID, targetID, userID uuid.UUID, isAdmin bool) error {
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(event, userID, isAdmin, PermManageParticipants); err != nil {
+		return err
+	}
+
+	participant, err := s.participantRepo.Find(eventID, targetID)
+	if err != nil || participant.Status != models.ParticipantStatusBanned {
+		return errors.New("user is not banned from this event")
+	}
+
+	return s.participantRepo.Delete(eventID, targetID)
+}
+
+// SetCheckedIn records (or clears) that a participant showed up
+func (s *EventService) SetCheckedIn(eventID, targetID, userID uuid.UUID, isAdmin, checkedIn bool) error {
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(event, userID, isAdmin, PermManageParticipants); err != nil {
+		return err
+	}
+
+	if event.Status == models.EventStatusCancelled {
+		return errors.New("cannot check in to a cancelled event")
+	}
+
+	participant, err := s.participantRepo.Find(eventID, targetID)
+	if err != nil || participant.Status != models.ParticipantStatusJoined {
+		return errors.New("user is not a participant of this event")
+	}
+
+	if checkedIn {
+		now := time.Now().UTC()
+		participant.CheckedInAt = &now
+	} else {
+		participant.CheckedInAt = nil
+	}
+	return s.participantRepo.Update(participant)
+}
+
+func (s *EventService) setParticipantStatus(eventID, targetID, userID uuid.UUID, isAdmin bool, status, reason string) error {
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(event, userID, isAdmin, PermManageParticipants); err != nil {
+		return err
+	}
+
+	if targetID == userID {
+		return errors.New("use leave to remove yourself from an event")
+	}
+	if targetID == event.CreatorID {
+		return errors.New("the event owner cannot be removed")
+	}
+
+	role, err := s.EventRoleFor(event, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	targetRole, err := s.EventRoleFor(event, targetID, false)
+	if err != nil {
+		return err
+	}
+	if !CanManageParticipant(role, targetRole) {
+		return ErrCoHostProtected
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("a reason is required")
+	}
+
+	participant, err := s.participantRepo.Find(eventID, targetID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if participant == nil {
+		if status != models.ParticipantStatusBanned {
+			return errors.New("user is not a participant of this event")
+		}
+		if _, err := s.userRepo.FindByID(targetID); err != nil {
+			return errors.New("user not found")
+		}
+		participant = &models.EventParticipant{
+			EventID: eventID,
+			UserID:  targetID,
+		}
+	} else if participant.Status == status {
+		return errors.New("user is already " + status)
+	} else if participant.Status == models.ParticipantStatusBanned {
+		return errors.New("user is banned from this event")
+	}
+	previousStatus := participant.Status
+	wasJoined := participant.ID != uuid.Nil && previousStatus == models.ParticipantStatusJoined
+
+	participant.Status = status
+	participant.RemovalReason = &reason
+	participant.RemovedBy = &userID
+	participant.RemovedAt = &now
+
+	isNew := participant.ID == uuid.Nil
+	if isNew {
+		err = s.participantRepo.Create(participant)
+	} else {
+		err = s.participantRepo.Update(participant)
+	}
+	if err != nil {
+		return err
+	}
+	if !isNew {
+		s.settleRemovedPayment(eventID, targetID, previousStatus)
+	}
+	if wasJoined {
+		s.publishParticipant(event, realtime.UpdateParticipantLeft, targetID, status)
+	}
+	s.notifyRemoved(event, participant, userID)
+
+	return s.refreshCapacityStatus(event)
+}
+
+// RemovalSettlement is what happens to a participant's payment when organizers
+// remove or ban them
+type RemovalSettlement int
+
+const (
+	// SettleNothing leaves payments alone; the user never paid for a spot
+	SettleNothing RemovalSettlement = iota
+	// SettleRefund refunds the payment for the spot the user loses
+	SettleRefund
+	// SettleCancelCheckout closes the open checkout so it cannot be paid any more
+	SettleCancelCheckout
+)
+
+// SettlementForRemoval returns how to settle the payment of a participant who had
+// the given status before being removed or banned
+func SettlementForRemoval(previousStatus string) RemovalSettlement {
+	switch previousStatus {
+	case models.ParticipantStatusJoined:
+		return SettleRefund
+	case models.ParticipantStatusPendingPayment:
+		return SettleCancelCheckout
+	}
+	return SettleNothing
+}
+
+// settleRemovedPayment refunds or closes the checkout of a removed or banned participant.
+// The removal stands either way; failures are logged and refunds are retried by the job.
+func (s *EventService) settleRemovedPayment(eventID, userID uuid.UUID, previousStatus string) {
+	switch SettlementForRemoval(previousStatus) {
+	case SettleRefund:
+		paid, err := s.paymentService.RefundablePayments(eventID, userID)
+		if err != nil {
+			log.Printf("payments: failed to look up payments of removed user %s for event %s: %v", userID, eventID, err)
+			return
+		}
+		for i := range paid {
+			if err := s.scheduleRefund(&paid[i]); err != nil {
+				log.Printf("payments: failed to schedule refund of payment %s: %v", paid[i].ID, err)
+			}
+		}
+	case SettleCancelCheckout:
+		if err := s.paymentService.CancelPending(eventID, userID); err != nil {
+			log.Printf("payments: failed to cancel checkout for event %s user %s: %v", eventID, userID, err)
+		}
+	}
+}
+
+func bannedMessage(participant *models.EventParticipant) string {
+	if participant.RemovalReason != nil && *participant.RemovalReason != "" {
+		return "you have been banned from this event: " + *participant.RemovalReason
+	}
+	return "you have been banned from this event"
+}
@@ -0,0 +1,29 @@
+package services_test
+
+import (
+	"testing"
+
+	"playspotter/internal/models"
+	"playspotter/internal/services"
+)
+
+func TestSettlementForRemoval(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   services.RemovalSettlement
+	}{
+		{"Paid Participant Is Refunded", models.ParticipantStatusJoined, services.SettleRefund},
+		{"Pending Payment Checkout Is Cancelled", models.ParticipantStatusPendingPayment, services.SettleCancelCheckout},
+		{"Removed User Is Left Alone", models.ParticipantStatusRemoved, services.SettleNothing},
+		{"Banned User Is Left Alone", models.ParticipantStatusBanned, services.SettleNothing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := services.SettlementForRemoval(tt.status); got != tt.want {
+				t.Errorf("SettlementForRemoval(%s) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
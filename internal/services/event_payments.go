@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"playspotter/internal/jobs"
+	"playspotter/internal/models"
+	"playspotter/internal/payments"
+	"playspotter/internal/realtime"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// JobPaymentRefund refunds a payment whose spot was given up or never granted
+const JobPaymentRefund = "payment_refund"
+
+// ErrPaymentNotFound is returned when a payment does not exist or belongs to another user
+var ErrPaymentNotFound = errors.New("payment not found")
+
+// SetPricing changes the price of an event. Existing reservations keep the amount
+// they were opened with.
+func (s *EventService) SetPricing(eventID uuid.UUID, priceCents int, currency string, userID uuid.UUID, isAdmin bool) error {
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(event, userID, isAdmin, PermEditEvent); err != nil {
+		return err
+	}
+
+	if err := normalizePricing(event, priceCents, currency); err != nil {
+		return err
+	}
+	if event.PriceCents > 0 && !s.paymentService.Enabled() {
+		return ErrPaymentsDisabled
+	}
+
+	return s.eventRepo.Update(event)
+}
+
+// FindCheckout returns the user's payment for a provider reference
+func (s *EventService) FindCheckout(providerRef string, userID uuid.UUID) (*models.Payment, error) {
+	payment, err := s.paymentService.FindByProviderRef(providerRef)
+	if err != nil || payment.UserID != userID {
+		return nil, ErrPaymentNotFound
+	}
+	return payment, nil
+}
+
+// HandlePaymentWebhook applies a signed provider callback. Each delivery is processed
+// once; repeated deliveries and callbacks for unknown payments are acknowledged without
+// side effects. Refunds, realtime updates and notifications only go out once the
+// callback's changes are committed.
+func (s *EventService) HandlePaymentWebhook(payload []byte, header http.Header) error {
+	event, err := s.paymentService.ParseWebhook(payload, header)
+	if err != nil {
+		return err
+	}
+
+	var effects []func(s *EventService)
+	err = s.paymentService.ProcessWebhook(event, func(tx *gorm.DB) error {
+		scoped := s.withTx(tx, &effects)
+
+		payment, err := scoped.paymentService.FindByProviderRef(event.ProviderRef)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Retrying will not make the payment appear, so let the provider stop
+			log.Printf("payments: ignoring webhook %s for unknown payment %s", event.ID, event.ProviderRef)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch event.Type {
+		case payments.EventPaymentSucceeded:
+			return scoped.confirmPayment(payment)
+		case payments.EventPaymentFailed:
+			return scoped.failPayment(payment)
+		}
+		// Other event types are acknowledged and ignored
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, effect := range effects {
+		effect(s)
+	}
+	return nil
+}
+
+// withTx returns a copy of the service whose event, participant, payment and job
+// queries run in the given transaction. Side effects passed to onCommit are added
+// to committed for the caller to run after the commit.
+func (s *EventService) withTx(tx *gorm.DB, committed *[]func(s *EventService)) *EventService {
+	scoped := *s
+	scoped.eventRepo = s.eventRepo.WithTx(tx)
+	scoped.participantRepo = s.participantRepo.WithTx(tx)
+	scoped.paymentService = s.paymentService.withTx(tx)
+	if s.jobRepo != nil {
+		scoped.jobRepo = s.jobRepo.WithTx(tx)
+	}
+	scoped.committed = committed
+	return &scoped
+}
+
+// onCommit runs fn with the unscoped service once the current transaction commits,
+// or right away outside a transaction
+func (s *EventService) onCommit(fn func(s *EventService)) {
+	if s.committed == nil {
+		fn(s)
+		return
+	}
+	*s.committed = append(*s.committed, fn)
+}
+
+// scheduleRefund queues the refund of a payment whose spot is gone. Inside a
+// transaction the job is stored with the rest of the changes, so the refund only
+// happens when they commit.
+func (s *EventService) scheduleRefund(payment *models.Payment) error {
+	if s.jobRepo == nil {
+		s.onCommit(func(s *EventService) {
+			if err := s.paymentService.Refund(payment); err != nil {
+				log.Printf("payments: refund of payment %s failed: %v", payment.ID, err)
+			}
+		})
+		return nil
+	}
+
+	key := fmt.Sprintf("%s:%s", JobPaymentRefund, payment.ID)
+	return s.jobRepo.Enqueue(&models.Job{
+		Type:        JobPaymentRefund,
+		Payload:     map[string]interface{}{"payment_id": payment.ID.String()},
+		UniqueKey:   &key,
+		RunAt:       time.Now().UTC(),
+		MaxAttempts: 8,
+	})
+}
+
+// RefundPaidPayment handles JobPaymentRefund jobs. A failed refund fails the job so it
+// is retried with backoff.
+func (s *EventService) RefundPaidPayment(ctx context.Context, job *models.Job) error {
+	paymentIDValue, _ := job.Payload["payment_id"].(string)
+	paymentID, err := uuid.Parse(paymentIDValue)
+	if err != nil {
+		return fmt.Errorf("%w: invalid payment_id", jobs.ErrPermanent)
+	}
+
+	payment, err := s.paymentService.FindByID(paymentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.scheduleRefund(payment)
+}
+
+// confirmPayment turns the reservation into a spot. When the spot is gone (event
+// cancelled, or the reservation lapsed and the event filled up) the payment is refunded.
+func (s *EventService) confirmPayment(payment *models.Payment) error {
+	if payment.Status != models.PaymentStatusPending && payment.Status != models.PaymentStatusExpired {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	payment.Status = models.PaymentStatusSucceeded
+	payment.PaidAt = &now
+	if err := s.paymentService.Update(payment); err != nil {
+		return err
+	}
+
+	event, err := s.eventRepo.FindByID(payment.EventID)
+	if err != nil {
+		return err
+	}
+
+	participant, err := s.participantRepo.Find(payment.EventID, payment.UserID)
+	if err != nil || participant.Status != models.ParticipantStatusPendingPayment || event.Status == models.EventStatusCancelled {
+		return s.scheduleRefund(payment)
+	}
+
+	if participant.PaymentExpiresAt == nil || !participant.PaymentExpiresAt.After(now) {
+		taken, err := s.participantRepo.CountByEvent(event.ID)
+		if err != nil {
+			return err
+		}
+		if int(taken) >= event.Capacity {
+			if err := s.participantRepo.Delete(event.ID, payment.UserID); err != nil {
+				return err
+			}
+			return s.scheduleRefund(payment)
+		}
+	}
+
+	participant.Status = models.ParticipantStatusJoined
+	participant.JoinedAt = now
+	participant.PaymentExpiresAt = nil
+	if err := s.participantRepo.Update(participant); err != nil {
+		return err
+	}
+	s.onCommit(func(s *EventService) {
+		s.publishParticipant(event, realtime.UpdateParticipantJoined, payment.UserID, "")
+		s.notifyJoined(event, payment.UserID)
+	})
+
+	return s.refreshCapacityStatus(event)
+}
+
+// failPayment releases the reservation held for a failed payment
+func (s *EventService) failPayment(payment *models.Payment) error {
+	if payment.Status != models.PaymentStatusPending {
+		return nil
+	}
+
+	payment.Status = models.PaymentStatusFailed
+	if err := s.paymentService.Update(payment); err != nil {
+		return err
+	}
+
+	participant, err := s.participantRepo.Find(payment.EventID, payment.UserID)
+	if err != nil || participant.Status != models.ParticipantStatusPendingPayment {
+		return nil
+	}
+
+	if err := s.participantRepo.Delete(payment.EventID, payment.UserID); err != nil {
+		return err
+	}
+
+	event, err := s.eventRepo.FindByID(payment.EventID)
+	if err != nil {
+		return err
+	}
+	return s.refreshCapacityStatus(event)
+}
+
+// normalizePricing validates and applies a price; currency defaults to the event's current one
+func normalizePricing(event *models.Event, priceCents int, currency string) error {
+	if priceCents < 0 {
+		return errors.New("price cannot be negative")
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = event.Currency
+	}
+	if currency == "" {
+		currency = "USD"
+	}
+	if !currencyPattern.MatchString(currency) {
+		return errors.New("currency must be a 3-letter ISO code")
+	}
+
+	event.PriceCents = priceCents
+	event.Currency = currency
+	return nil
+}
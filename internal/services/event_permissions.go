@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// ErrEventForbidden is returned when the caller lacks the permission an event action needs
+var ErrEventForbidden = errors.New("you do not have permission to manage this event")
+
+// ErrEventNotFound is returned when an event does not exist or is not visible to the caller
+var ErrEventNotFound = errors.New("event not found")
+
+// ErrCoHostProtected is returned when a co-host tries to remove or ban another co-host
+var ErrCoHostProtected = errors.New("only the event owner or an admin can remove or ban a co-host")
+
+// EventPermission is an action on an event that not every user may perform
+type EventPermission int
+
+const (
+	// PermEditEvent covers changing event details
+	PermEditEvent EventPermission = iota
+	// PermManageParticipants covers roster actions on other users
+	PermManageParticipants
+	// PermCancelEvent covers cancelling the event
+	PermCancelEvent
+	// PermManageCoHosts covers adding and removing co-hosts
+	PermManageCoHosts
+	// PermTransferOwnership covers handing the event to another user
+	PermTransferOwnership
+	// PermModerateComments covers pinning and deleting other users' comments
+	PermModerateComments
+)
+
+// EventRole is the caller's relationship to an event
+type EventRole string
+
+const (
+	EventRoleAdmin  EventRole = "admin"
+	EventRoleOwner  EventRole = "owner"
+	EventRoleCoHost EventRole = "cohost"
+	EventRoleNone   EventRole = "none"
+)
+
+// eventRolePermissions lists what each role may do; admins and owners may do everything
+var eventRolePermissions = map[EventRole]map[EventPermission]bool{
+	EventRoleCoHost: {
+		PermEditEvent:          true,
+		PermManageParticipants: true,
+		PermModerateComments:   true,
+	},
+}
+
+// EventRoleFor resolves the caller's role on the event
+func (s *EventService) EventRoleFor(event *models.Event, userID uuid.UUID, isAdmin bool) (EventRole, error) {
+	if isAdmin {
+		return EventRoleAdmin, nil
+	}
+	if event.CreatorID == userID {
+		return EventRoleOwner, nil
+	}
+
+	isCoHost, err := s.cohostRepo.Exists(event.ID, userID)
+	if err != nil {
+		return EventRoleNone, err
+	}
+	if isCoHost {
+		return EventRoleCoHost, nil
+	}
+
+	return EventRoleNone, nil
+}
+
+// CanManageParticipant reports whether a caller with the given role may remove or ban a
+// user holding targetRole. Co-hosts share roster rights, so only the owner or an admin
+// may act on a co-host, and nobody may act on the owner.
+func CanManageParticipant(role, targetRole EventRole) bool {
+	switch targetRole {
+	case EventRoleOwner:
+		return false
+	case EventRoleCoHost:
+		return role == EventRoleOwner || role == EventRoleAdmin
+	}
+	return role == EventRoleOwner || role == EventRoleAdmin || eventRolePermissions[role][PermManageParticipants]
+}
+
+// authorize returns ErrEventForbidden unless the caller's role grants the permission
+func (s *EventService) authorize(event *models.Event, userID uuid.UUID, isAdmin bool, perm EventPermission) error {
+	role, err := s.EventRoleFor(event, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+
+	if role == EventRoleAdmin || role == EventRoleOwner || eventRolePermissions[role][perm] {
+		return nil
+	}
+
+	return ErrEventForbidden
+}
@@ -0,0 +1,34 @@
+package services_test
+
+import (
+	"testing"
+
+	"playspotter/internal/services"
+)
+
+func TestCanManageParticipant(t *testing.T) {
+	tests := []struct {
+		name   string
+		role   services.EventRole
+		target services.EventRole
+		want   bool
+	}{
+		{"Owner Removes Participant", services.EventRoleOwner, services.EventRoleNone, true},
+		{"Co-host Removes Participant", services.EventRoleCoHost, services.EventRoleNone, true},
+		{"Admin Removes Participant", services.EventRoleAdmin, services.EventRoleNone, true},
+		{"Stranger Removes Participant", services.EventRoleNone, services.EventRoleNone, false},
+		{"Owner Removes Co-host", services.EventRoleOwner, services.EventRoleCoHost, true},
+		{"Admin Removes Co-host", services.EventRoleAdmin, services.EventRoleCoHost, true},
+		{"Co-host Removes Co-host", services.EventRoleCoHost, services.EventRoleCoHost, false},
+		{"Co-host Removes Owner", services.EventRoleCoHost, services.EventRoleOwner, false},
+		{"Admin Removes Owner", services.EventRoleAdmin, services.EventRoleOwner, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := services.CanManageParticipant(tt.role, tt.target); got != tt.want {
+				t.Errorf("CanManageParticipant(%s, %s) = %v, want %v", tt.role, tt.target, got, tt.want)
+			}
+		})
+	}
+}
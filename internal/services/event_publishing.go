@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"playspotter/internal/jobs"
+	"playspotter/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// JobEventPublish publishes a draft at the time its organizer chose
+const JobEventPublish = "event_publish"
+
+// ViewEvent returns the event if the caller may see it. Drafts and events hidden by
+// moderators are only visible to their organizers and admins; everyone else gets
+// ErrEventNotFound.
+func (s *EventService) ViewEvent(id uuid.UUID, userID *uuid.UUID, isAdmin bool) (*models.Event, error) {
+	event, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrEventNotFound
+	}
+
+	if !s.canSee(event, userID, isAdmin) {
+		return nil, ErrEventNotFound
+	}
+	return event, nil
+}
+
+// canSee reports whether the caller may see the event at all: drafts and events
+// hidden by moderators are limited to their organizers
+func (s *EventService) canSee(event *models.Event, userID *uuid.UUID, isAdmin bool) bool {
+	if event.Status != models.EventStatusDraft && event.HiddenAt == nil {
+		return true
+	}
+	return userID != nil && s.authorize(event, *userID, isAdmin, PermEditEvent) == nil
+}
+
+// ListDrafts returns the drafts the user owns or co-hosts
+func (s *EventService) ListDrafts(userID uuid.UUID, offset, limit int) ([]models.Event, int64, error) {
+	return s.eventRepo.ListDrafts(userID, offset, limit)
+}
+
+// PublishEvent makes a draft visible right away (owner, co-host or admin)
+func (s *EventService) PublishEvent(id, userID uuid.UUID, isAdmin bool) (*models.Event, error) {
+	event, err := s.findDraft(id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publishDraft(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// SchedulePublish sets or moves the time at which a draft is published
+func (s *EventService) SchedulePublish(id, userID uuid.UUID, isAdmin bool, publishAt time.Time) (*models.Event, error) {
+	event, err := s.findDraft(id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	publishAt = publishAt.UTC().Truncate(time.Second)
+	if err := validatePublishAt(event, publishAt); err != nil {
+		return nil, err
+	}
+
+	event.PublishAt = &publishAt
+	if err := s.eventRepo.Update(event); err != nil {
+		return nil, err
+	}
+	s.schedulePublish(event)
+	return event, nil
+}
+
+// UnschedulePublish keeps a draft unpublished until it is published manually
+func (s *EventService) UnschedulePublish(id, userID uuid.UUID, isAdmin bool) error {
+	event, err := s.findDraft(id, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+
+	if event.PublishAt == nil {
+		return errors.New("event is not scheduled for publishing")
+	}
+
+	// The queued job notices the change and skips itself
+	event.PublishAt = nil
+	return s.eventRepo.Update(event)
+}
+
+// PublishScheduledEvent handles JobEventPublish jobs. Jobs for drafts that were
+// published, unscheduled or rescheduled in the meantime are dropped.
+func (s *EventService) PublishScheduledEvent(ctx context.Context, job *models.Job) error {
+	eventIDValue, _ := job.Payload["event_id"].(string)
+	eventID, err := uuid.Parse(eventIDValue)
+	if err != nil {
+		return fmt.Errorf("%w: invalid event_id", jobs.ErrPermanent)
+	}
+	scheduledFor, err := parseJobTime(job, "publish_at")
+	if err != nil {
+		return err
+	}
+
+	event, err := s.eventRepo.FindByID(eventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if event.Status != models.EventStatusDraft || event.PublishAt == nil || !sameSecond(*event.PublishAt, scheduledFor) {
+		return nil
+	}
+	if !event.EventTime.After(time.Now().UTC()) {
+		log.Printf("jobs: not publishing event %s, it has already started", event.ID)
+		return nil
+	}
+
+	return s.publishDraft(event)
+}
+
+// publishDraft opens the event for players and announces it
+func (s *EventService) publishDraft(event *models.Event) error {
+	if !event.EventTime.After(time.Now().UTC()) {
+		return errors.New("event time must be in the future")
+	}
+
+	now := time.Now().UTC()
+	event.Status = models.EventStatusOpen
+	event.PublishAt = nil
+	event.PublishedAt = &now
+	if err := s.eventRepo.Update(event); err != nil {
+		return err
+	}
+
+	s.publishStatus(event)
+	s.scheduleReminders(event)
+	return nil
+}
+
+// schedulePublish queues the job that publishes the draft at its publish time
+func (s *EventService) schedulePublish(event *models.Event) {
+	if s.jobRepo == nil || event.PublishAt == nil {
+		return
+	}
+
+	key := fmt.Sprintf("%s:%s:%d", JobEventPublish, event.ID, event.PublishAt.Unix())
+	job := &models.Job{
+		Type: JobEventPublish,
+		Payload: map[string]interface{}{
+			"event_id":   event.ID.String(),
+			"publish_at": jobTime(*event.PublishAt),
+		},
+		UniqueKey:   &key,
+		RunAt:       *event.PublishAt,
+		MaxAttempts: 5,
+	}
+	if err := s.jobRepo.Enqueue(job); err != nil {
+		log.Printf("jobs: failed to schedule publishing of event %s: %v", event.ID, err)
+	}
+}
+
+func (s *EventService) findDraft(id, userID uuid.UUID, isAdmin bool) (*models.Event, error) {
+	event, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrEventNotFound
+	}
+
+	if err := s.authorize(event, userID, isAdmin, PermEditEvent); err != nil {
+		return nil, err
+	}
+
+	if event.Status != models.EventStatusDraft {
+		return nil, errors.New("event is already published")
+	}
+	return event, nil
+}
+
+func validatePublishAt(event *models.Event, publishAt time.Time) error {
+	if !publishAt.After(time.Now().UTC()) {
+		return errors.New("publish time must be in the future")
+	}
+	if !publishAt.Before(event.EventTime) {
+		return errors.New("publish time must be before the event starts")
+	}
+	return nil
+}
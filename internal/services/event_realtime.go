@@ -0,0 +1,69 @@
+package services
+
+import (
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+// publish sends an update about the event to realtime subscribers
+func (s *EventService) publish(event *models.Event, updateType string, data map[string]interface{}) {
+	// Drafts are private to their organizers
+	if s.hub == nil || event.Status == models.EventStatusDraft {
+		return
+	}
+
+	s.hub.Publish(realtime.Update{
+		Type:      updateType,
+		EventID:   event.ID,
+		Data:      data,
+		Latitude:  event.Latitude,
+		Longitude: event.Longitude,
+		// The discussion of participants-only events stays with those allowed to read it
+		Private: isCommentUpdate(updateType) && event.Visibility == models.EventVisibilityParticipants,
+	})
+}
+
+// publishParticipant announces a join or leave together with the new head count
+func (s *EventService) publishParticipant(event *models.Event, updateType string, userID uuid.UUID, reason string) {
+	if s.hub == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"user_id":  userID,
+		"capacity": event.Capacity,
+	}
+	if reason != "" {
+		data["reason"] = reason
+	}
+
+	count, err := s.participantRepo.CountByEvent(event.ID)
+	if err != nil {
+		log.Printf("realtime: failed to count participants of event %s: %v", event.ID, err)
+	} else {
+		data["participant_count"] = count
+	}
+
+	s.publish(event, updateType, data)
+}
+
+func (s *EventService) publishStatus(event *models.Event) {
+	data := map[string]interface{}{
+		"status": event.Status,
+	}
+	if event.Status == models.EventStatusCancelled {
+		data["cancellation_reason"] = event.CancellationReason
+	}
+	s.publish(event, realtime.UpdateStatusChanged, data)
+}
+
+func isCommentUpdate(updateType string) bool {
+	switch updateType {
+	case realtime.UpdateCommentPosted, realtime.UpdateCommentEdited, realtime.UpdateCommentDeleted:
+		return true
+	}
+	return false
+}
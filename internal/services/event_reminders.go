@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"playspotter/internal/jobs"
+	"playspotter/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// JobEventReminder reminds participants shortly before an event starts
+const JobEventReminder = "event_reminder"
+
+// eventReminderOffsets are how long before the start participants are reminded
+var eventReminderOffsets = []time.Duration{24 * time.Hour, time.Hour}
+
+// scheduleReminders queues the reminders for the event's current start time.
+// Reminders queued for an earlier start time notice the change and skip themselves.
+func (s *EventService) scheduleReminders(event *models.Event) {
+	if s.jobRepo == nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, offset := range eventReminderOffsets {
+		runAt := event.EventTime.Add(-offset)
+		if runAt.Before(now) {
+			continue
+		}
+
+		key := fmt.Sprintf("%s:%s:%d:%d", JobEventReminder, event.ID, int(offset.Minutes()), event.EventTime.Unix())
+		job := &models.Job{
+			Type: JobEventReminder,
+			Payload: map[string]interface{}{
+				"event_id":       event.ID.String(),
+				"event_time":     jobTime(event.EventTime),
+				"offset_minutes": int(offset.Minutes()),
+			},
+			UniqueKey:   &key,
+			RunAt:       runAt,
+			MaxAttempts: 3,
+		}
+		if err := s.jobRepo.Enqueue(job); err != nil {
+			log.Printf("jobs: failed to schedule reminder for event %s: %v", event.ID, err)
+		}
+	}
+}
+
+// SendEventReminder handles JobEventReminder jobs. Reminders for events that were
+// cancelled, unpublished, rescheduled or have already started are dropped.
+func (s *EventService) SendEventReminder(ctx context.Context, job *models.Job) error {
+	eventIDValue, _ := job.Payload["event_id"].(string)
+	eventID, err := uuid.Parse(eventIDValue)
+	if err != nil {
+		return fmt.Errorf("%w: invalid event_id", jobs.ErrPermanent)
+	}
+	scheduledFor, err := parseJobTime(job, "event_time")
+	if err != nil {
+		return err
+	}
+
+	event, err := s.eventRepo.FindByID(eventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	rescheduled := !sameSecond(event.EventTime, scheduledFor)
+	if event.Status == models.EventStatusCancelled || event.Status == models.EventStatusDraft || rescheduled || !event.EventTime.After(time.Now().UTC()) {
+		return nil
+	}
+
+	participants, err := s.participantRepo.ListByEvent(event.ID, models.ParticipantStatusJoined)
+	if err != nil {
+		return err
+	}
+	userIDs := make([]uuid.UUID, 0, len(participants))
+	for _, p := range participants {
+		userIDs = append(userIDs, p.UserID)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	offsetMinutes, _ := job.Payload["offset_minutes"].(float64)
+	return s.notificationService.Notify(userIDs, NotificationInput{
+		Type:    models.NotificationEventReminder,
+		EventID: &event.ID,
+		Title:   "Starting soon",
+		Body:    fmt.Sprintf("%s starts in %s (%s).", event.Title, formatLeadTime(time.Duration(offsetMinutes)*time.Minute), formatEventTime(event)),
+	})
+}
+
+func formatLeadTime(d time.Duration) string {
+	hours := int(d.Hours())
+	switch {
+	case hours == 1:
+		return "1 hour"
+	case hours > 1:
+		return fmt.Sprintf("%d hours", hours)
+	default:
+		return fmt.Sprintf("%d minutes", int(d.Minutes()))
+	}
+}
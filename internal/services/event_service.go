@@ -2,46 +2,104 @@ package services
 
 import (
 	"errors"
+	"log"
 	"playspotter/internal/models"
+	"playspotter/internal/realtime"
 	"playspotter/internal/repositories"
+	"playspotter/internal/timezone"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type EventService struct {
-	eventRepo       *repositories.EventRepository
-	participantRepo *repositories.ParticipantRepository
+	eventRepo           *repositories.EventRepository
+	participantRepo     *repositories.ParticipantRepository
+	venueRepo           *repositories.VenueRepository
+	cohostRepo          *repositories.CoHostRepository
+	userRepo            *repositories.UserRepository
+	sportService        *SportService
+	paymentService      *PaymentService
+	notificationService *NotificationService
+	jobRepo             *repositories.JobRepository
+	blockRepo           *repositories.BlockRepository
+	auditService        *AuditService
+	hub                 *realtime.Hub
+	cancellationWindow  time.Duration
+
+	// committed collects side effects to run once the transaction of a service
+	// scoped with withTx commits; nil outside a transaction
+	committed *[]func(s *EventService)
 }
 
-func NewEventService(eventRepo *repositories.EventRepository, participantRepo *repositories.ParticipantRepository) *EventService {
+func NewEventService(
+	eventRepo *repositories.EventRepository,
+	participantRepo *repositories.ParticipantRepository,
+	venueRepo *repositories.VenueRepository,
+	cohostRepo *repositories.CoHostRepository,
+	userRepo *repositories.UserRepository,
+	sportService *SportService,
+	paymentService *PaymentService,
+	notificationService *NotificationService,
+	jobRepo *repositories.JobRepository,
+	blockRepo *repositories.BlockRepository,
+	auditService *AuditService,
+	hub *realtime.Hub,
+	cancellationWindow time.Duration,
+) *EventService {
 	return &EventService{
-		eventRepo:       eventRepo,
-		participantRepo: participantRepo,
+		eventRepo:           eventRepo,
+		participantRepo:     participantRepo,
+		venueRepo:           venueRepo,
+		cohostRepo:          cohostRepo,
+		userRepo:            userRepo,
+		sportService:        sportService,
+		paymentService:      paymentService,
+		notificationService: notificationService,
+		jobRepo:             jobRepo,
+		blockRepo:           blockRepo,
+		auditService:        auditService,
+		hub:                 hub,
+		cancellationWindow:  cancellationWindow,
 	}
 }
 
 func (s *EventService) CreateEvent(event *models.Event) error {
+	if err := s.prepareEvent(event); err != nil {
+		return err
+	}
+
 	// Validate event time is in the future
 	if event.EventTime.Before(time.Now().UTC()) {
 		return errors.New("event time must be in the future")
 	}
 
-	// Validate coordinates
-	if event.Latitude < -90 || event.Latitude > 90 {
-		return errors.New("latitude must be between -90 and 90")
+	// Events scheduled for publishing start out as drafts
+	if event.PublishAt != nil {
+		publishAt := event.PublishAt.UTC().Truncate(time.Second)
+		event.PublishAt = &publishAt
+		event.Status = models.EventStatusDraft
+		if err := validatePublishAt(event, *event.PublishAt); err != nil {
+			return err
+		}
 	}
-	if event.Longitude < -180 || event.Longitude > 180 {
-		return errors.New("longitude must be between -180 and 180")
+	if event.Status != models.EventStatusDraft {
+		now := time.Now().UTC()
+		event.Status = models.EventStatusOpen
+		event.PublishedAt = &now
 	}
 
-	// Validate capacity
-	if event.Capacity < 1 {
-		return errors.New("capacity must be at least 1")
+	if err := s.eventRepo.Create(event); err != nil {
+		return err
 	}
 
-	event.Status = "open"
-	return s.eventRepo.Create(event)
+	if event.Status == models.EventStatusDraft {
+		s.schedulePublish(event)
+		return nil
+	}
+	s.scheduleReminders(event)
+	return nil
 }
 
 func (s *EventService) GetEvent(id uuid.UUID) (*models.Event, error) {
@@ -54,9 +112,12 @@ func (s *EventService) UpdateEvent(id uuid.UUID, updates *models.Event, userID u
 		return err
 	}
 
-	// Check permissions
-	if !isAdmin && event.CreatorID != userID {
-		return errors.New("only event creator or admin can update this event")
+	if err := s.authorize(event, userID, isAdmin, PermEditEvent); err != nil {
+		return err
+	}
+
+	if event.Status == models.EventStatusCompleted {
+		return errors.New("cannot edit a completed event")
 	}
 
 	// Validate event time if being updated
@@ -64,15 +125,24 @@ func (s *EventService) UpdateEvent(id uuid.UUID, updates *models.Event, userID u
 		return errors.New("event time must be in the future")
 	}
 
+	previousTime, previousLat, previousLng := event.EventTime, event.Latitude, event.Longitude
+
 	// Update fields
 	if updates.Title != "" {
 		event.Title = updates.Title
 	}
 	if updates.SportType != "" {
-		event.SportType = updates.SportType
+		sport, err := s.sportService.Resolve(updates.SportType)
+		if err != nil {
+			return err
+		}
+		event.SportType = sport.Slug
 	}
-	if !updates.EventTime.IsZero() {
-		event.EventTime = updates.EventTime
+	if updates.DurationMin != 0 {
+		if !validDuration(updates.DurationMin) {
+			return errors.New("duration must be between 15 and 1440 minutes")
+		}
+		event.DurationMin = updates.DurationMin
 	}
 	if updates.LocationName != nil {
 		event.LocationName = updates.LocationName
@@ -98,126 +168,559 @@ func (s *EventService) UpdateEvent(id uuid.UUID, updates *models.Event, userID u
 	if updates.Description != nil {
 		event.Description = updates.Description
 	}
+	if updates.Visibility != "" {
+		if !validVisibility(updates.Visibility) {
+			return errors.New("visibility must be public or participants")
+		}
+		event.Visibility = updates.Visibility
+	}
+	if updates.VenueID != nil {
+		if err := s.applyVenue(event, *updates.VenueID); err != nil {
+			return err
+		}
+	} else if updates.Latitude != 0 || updates.Longitude != 0 {
+		// Raw coordinates detach the event from its venue
+		event.VenueID = nil
+	} else if updates.SportType != "" && event.VenueID != nil {
+		// A new sport must still be played at the event's venue
+		if _, err := s.findVenueForSport(*event.VenueID, event.SportType); err != nil {
+			return err
+		}
+	}
 
-	return s.eventRepo.Update(event)
+	// Moving the event takes it to the zone of its new location unless one is given
+	if updates.TimeZone != "" {
+		event.TimeZone = updates.TimeZone
+	} else if event.Latitude != previousLat || event.Longitude != previousLng {
+		event.TimeZone = timezone.ForCoordinates(event.Latitude, event.Longitude)
+	}
+	loc, err := timezone.Load(event.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	// A start time without an offset is a wall-clock time in the event's zone
+	if updates.EventTime.IsZero() && !updates.LocalEventTime.IsZero() {
+		updates.EventTime = timezone.WallClock(updates.LocalEventTime, loc).UTC()
+		if updates.EventTime.Before(time.Now().UTC()) {
+			return errors.New("event time must be in the future")
+		}
+	}
+	if !updates.EventTime.IsZero() {
+		event.EventTime = updates.EventTime
+		// Moving a running event to a later date takes players again
+		if event.Status == models.EventStatusOngoing {
+			event.Status = models.EventStatusOpen
+		}
+	}
+	if event.PublishAt != nil && !event.PublishAt.Before(event.EventTime) {
+		return errors.New("publish time must be before the event starts")
+	}
+	event.LocalEventTime = event.EventTime.In(loc)
+
+	if err := s.eventRepo.Update(event); err != nil {
+		return err
+	}
+	// Nobody but the organizers knows about a draft yet
+	if event.Status == models.EventStatusDraft {
+		return nil
+	}
+	s.publish(event, realtime.UpdateEventUpdated, nil)
+	if !event.EventTime.Equal(previousTime) || event.Latitude != previousLat || event.Longitude != previousLng {
+		s.notifyRescheduled(event, userID)
+	}
+	if !event.EventTime.Equal(previousTime) {
+		s.scheduleReminders(event)
+	}
+
+	// A capacity change can fill or reopen the event
+	return s.refreshCapacityStatus(event)
 }
 
-func (s *EventService) DeleteEvent(id uuid.UUID, userID uuid.UUID, isAdmin bool) error {
+// DeleteEvent cancels the event with the given reason. Organizers cannot cancel
+// within the cancellation window before the start; admins can.
+func (s *EventService) DeleteEvent(id uuid.UUID, userID uuid.UUID, isAdmin bool, reason string) error {
 	event, err := s.eventRepo.FindByID(id)
 	if err != nil {
 		return err
 	}
 
-	// Check permissions
-	if !isAdmin && event.CreatorID != userID {
-		return errors.New("only event creator or admin can delete this event")
+	if err := s.authorize(event, userID, isAdmin, PermCancelEvent); err != nil {
+		return err
+	}
+
+	if event.Status == models.EventStatusCompleted {
+		return errors.New("cannot cancel a completed event")
+	}
+	if !isAdmin && s.withinCancellationWindow(event) {
+		return ErrCancellationWindow
 	}
 
-	// Set status to cancelled instead of deleting
-	event.Status = "cancelled"
-	return s.eventRepo.Update(event)
+	return s.cancel(event, userID, reason)
 }
 
-func (s *EventService) JoinEvent(eventID, userID uuid.UUID) error {
+// JoinEvent adds the user to the event. For paid events the spot is only reserved
+// and the returned payment must be completed before it expires.
+func (s *EventService) JoinEvent(eventID, userID uuid.UUID) (*models.Payment, error) {
 	event, err := s.eventRepo.FindByID(eventID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check if event is cancelled
-	if event.Status == "cancelled" {
-		return errors.New("cannot join cancelled event")
+	if event.Status == models.EventStatusCancelled {
+		return nil, errors.New("cannot join cancelled event")
 	}
 
-	// Check if event is full
-	if event.Status == "full" {
-		return errors.New("event is full")
+	if event.HiddenAt != nil {
+		return nil, errors.New("event has been hidden by a moderator")
 	}
 
 	// Check if event time has passed
 	if event.EventTime.Before(time.Now().UTC()) {
-		return errors.New("cannot join past event")
+		return nil, errors.New("cannot join past event")
 	}
 
-	// Check if already joined
-	exists, err := s.participantRepo.Exists(eventID, userID)
+	if event.Status != models.EventStatusOpen && event.Status != models.EventStatusFull {
+		return nil, errors.New("event is not open for joining")
+	}
+
+	// Organizers who blocked the user keep them out of their events
+	organizers := []uuid.UUID{event.CreatorID}
+	for _, cohost := range event.CoHosts {
+		organizers = append(organizers, cohost.UserID)
+	}
+	blocked, err := s.blockRepo.BlockedByAny(organizers, userID)
 	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, errors.New("you cannot join this event")
+	}
+
+	// Check for an earlier participation record (joined, reserved, removed or banned)
+	participant, err := s.participantRepo.Find(eventID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if participant != nil {
+		switch participant.Status {
+		case models.ParticipantStatusJoined:
+			return nil, errors.New("already joined this event")
+		case models.ParticipantStatusBanned:
+			return nil, errors.New(bannedMessage(participant))
+		case models.ParticipantStatusPendingPayment:
+			// Joining again while the reservation holds returns the same checkout
+			if participant.PaymentExpiresAt != nil && participant.PaymentExpiresAt.After(time.Now().UTC()) {
+				return s.paymentService.ActivePayment(eventID, userID)
+			}
+		}
+	}
+
+	// Expired payment reservations free their spots again
+	if err := s.refreshCapacityStatus(event); err != nil {
+		return nil, err
+	}
+
+	// Check if event is full
+	if event.Status == models.EventStatusFull {
+		return nil, errors.New("event is full")
+	}
+
+	status := models.ParticipantStatusJoined
+	var payment *models.Payment
+	if event.PriceCents > 0 {
+		payment, err = s.paymentService.StartPayment(event, userID)
+		if err != nil {
+			return nil, err
+		}
+		status = models.ParticipantStatusPendingPayment
+	}
+
+	if participant != nil {
+		// Previously removed participants and lapsed reservations may join again
+		participant.Status = status
+		participant.JoinedAt = time.Now().UTC()
+		participant.PaymentExpiresAt = nil
+		participant.RemovalReason = nil
+		participant.RemovedBy = nil
+		participant.RemovedAt = nil
+		if payment != nil {
+			participant.PaymentExpiresAt = &payment.ExpiresAt
+		}
+		if err := s.participantRepo.Update(participant); err != nil {
+			return nil, err
+		}
+	} else {
+		// Add participant
+		participant = &models.EventParticipant{
+			EventID: eventID,
+			UserID:  userID,
+			Status:  status,
+		}
+		if payment != nil {
+			participant.PaymentExpiresAt = &payment.ExpiresAt
+		}
+		if err := s.participantRepo.Create(participant); err != nil {
+			return nil, err
+		}
+	}
+
+	if payment == nil {
+		s.publishParticipant(event, realtime.UpdateParticipantJoined, userID, "")
+		s.notifyJoined(event, userID)
+	}
+
+	return payment, s.refreshCapacityStatus(event)
+}
+
+func (s *EventService) LeaveEvent(eventID, userID uuid.UUID) error {
+	// Participants and users still holding a paid reservation may leave
+	participant, err := s.participantRepo.Find(eventID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
 	}
-	if exists {
-		return errors.New("already joined this event")
+	if participant == nil || (participant.Status != models.ParticipantStatusJoined && participant.Status != models.ParticipantStatusPendingPayment) {
+		return errors.New("you are not a participant of this event")
 	}
 
-	// Add participant
-	participant := &models.EventParticipant{
-		EventID: eventID,
-		UserID:  userID,
+	// Remove participant
+	if err := s.participantRepo.Delete(eventID, userID); err != nil {
+		return err
 	}
-	if err := s.participantRepo.Create(participant); err != nil {
+
+	// Close the checkout so the reservation cannot be paid for after leaving
+	if participant.Status == models.ParticipantStatusPendingPayment {
+		if err := s.paymentService.CancelPending(eventID, userID); err != nil {
+			log.Printf("payments: failed to cancel checkout for event %s user %s: %v", eventID, userID, err)
+		}
+	}
+
+	// Update event status if it was full
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
 		return err
 	}
+	if participant.Status == models.ParticipantStatusJoined {
+		s.publishParticipant(event, realtime.UpdateParticipantLeft, userID, "left")
+	}
+
+	return s.refreshCapacityStatus(event)
+}
+
+// refreshCapacityStatus flips an event between open and full to match its active participant count
+func (s *EventService) refreshCapacityStatus(event *models.Event) error {
+	if event.Status != models.EventStatusOpen && event.Status != models.EventStatusFull {
+		return nil
+	}
 
-	// Check if event is now full
-	count, err := s.participantRepo.CountByEvent(eventID)
+	count, err := s.participantRepo.CountByEvent(event.ID)
 	if err != nil {
 		return err
 	}
 
+	status := models.EventStatusOpen
 	if int(count) >= event.Capacity {
-		event.Status = "full"
-		return s.eventRepo.Update(event)
+		status = models.EventStatusFull
+	}
+	if status == event.Status {
+		return nil
 	}
 
+	event.Status = status
+	if err := s.eventRepo.Update(event); err != nil {
+		return err
+	}
+	s.onCommit(func(s *EventService) {
+		s.publishStatus(event)
+		if status == models.EventStatusFull {
+			s.notifyFull(event)
+		}
+	})
 	return nil
 }
 
-func (s *EventService) LeaveEvent(eventID, userID uuid.UUID) error {
-	// Check if user is participant
-	exists, err := s.participantRepo.Exists(eventID, userID)
+// prepareEvent validates and normalizes the details shared by events and templates:
+// sport, location, time zone, capacity, pricing, duration and visibility
+func (s *EventService) prepareEvent(event *models.Event) error {
+	// Store the catalog slug rather than whatever spelling the client sent
+	sport, err := s.sportService.Resolve(event.SportType)
 	if err != nil {
 		return err
 	}
-	if !exists {
-		return errors.New("you are not a participant of this event")
+	event.SportType = sport.Slug
+
+	// Take the location from the venue when one is referenced
+	if event.VenueID != nil {
+		if err := s.applyVenue(event, *event.VenueID); err != nil {
+			return err
+		}
 	}
 
-	// Remove participant
-	if err := s.participantRepo.Delete(eventID, userID); err != nil {
+	// Validate coordinates
+	if event.Latitude < -90 || event.Latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if event.Longitude < -180 || event.Longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+
+	// Default the zone from the location; organizers can name it explicitly
+	if event.TimeZone == "" {
+		event.TimeZone = timezone.ForCoordinates(event.Latitude, event.Longitude)
+	}
+	loc, err := timezone.Load(event.TimeZone)
+	if err != nil {
 		return err
 	}
 
-	// Update event status if it was full
+	// A start time without an offset is a wall-clock time in the event's zone
+	if event.EventTime.IsZero() && !event.LocalEventTime.IsZero() {
+		event.EventTime = timezone.WallClock(event.LocalEventTime, loc).UTC()
+	}
+	event.LocalEventTime = event.EventTime.In(loc)
+
+	// Validate capacity
+	if event.Capacity < 1 {
+		return errors.New("capacity must be at least 1")
+	}
+
+	if err := normalizePricing(event, event.PriceCents, event.Currency); err != nil {
+		return err
+	}
+	if event.PriceCents > 0 && !s.paymentService.Enabled() {
+		return ErrPaymentsDisabled
+	}
+
+	if event.DurationMin == 0 {
+		event.DurationMin = models.DefaultEventDuration
+	}
+	if !validDuration(event.DurationMin) {
+		return errors.New("duration must be between 15 and 1440 minutes")
+	}
+
+	if event.Visibility == "" {
+		event.Visibility = models.EventVisibilityPublic
+	}
+	if !validVisibility(event.Visibility) {
+		return errors.New("visibility must be public or participants")
+	}
+
+	return nil
+}
+
+func validDuration(minutes int) bool {
+	return minutes >= 15 && minutes <= 1440
+}
+
+func validVisibility(visibility string) bool {
+	return visibility == models.EventVisibilityPublic || visibility == models.EventVisibilityParticipants
+}
+
+// applyVenue links the event to a venue and copies the venue's location onto it
+func (s *EventService) applyVenue(event *models.Event, venueID uuid.UUID) error {
+	venue, err := s.findVenueForSport(venueID, event.SportType)
+	if err != nil {
+		return err
+	}
+
+	event.VenueID = &venue.ID
+	event.LocationName = &venue.Name
+	event.Address = venue.Address
+	event.Latitude = venue.Latitude
+	event.Longitude = venue.Longitude
+	return nil
+}
+
+// findVenueForSport loads a venue and checks that the sport can be played there
+func (s *EventService) findVenueForSport(venueID uuid.UUID, sportType string) (*models.Venue, error) {
+	venue, err := s.venueRepo.FindByID(venueID)
+	if err != nil {
+		return nil, errors.New("venue not found")
+	}
+
+	if !venue.SupportsSport(sportType) {
+		return nil, errors.New("venue does not support this sport")
+	}
+	return venue, nil
+}
+
+// ListCoHosts returns the co-hosts of an event the caller may see
+func (s *EventService) ListCoHosts(eventID uuid.UUID, userID *uuid.UUID, isAdmin bool) ([]models.EventCoHost, error) {
+	if _, err := s.ViewEvent(eventID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.cohostRepo.ListByEvent(eventID)
+}
+
+func (s *EventService) AddCoHost(eventID, cohostID, userID uuid.UUID, isAdmin bool) error {
 	event, err := s.eventRepo.FindByID(eventID)
 	if err != nil {
 		return err
 	}
 
-	if event.Status == "full" {
-		event.Status = "open"
-		return s.eventRepo.Update(event)
+	if err := s.authorize(event, userID, isAdmin, PermManageCoHosts); err != nil {
+		return err
+	}
+
+	if event.CreatorID == cohostID {
+		return errors.New("the event owner cannot be a co-host")
 	}
 
-	return nil
+	if _, err := s.userRepo.FindByID(cohostID); err != nil {
+		return errors.New("user not found")
+	}
+
+	exists, err := s.cohostRepo.Exists(eventID, cohostID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New("user is already a co-host")
+	}
+
+	return s.cohostRepo.Create(&models.EventCoHost{
+		EventID: eventID,
+		UserID:  cohostID,
+		AddedBy: &userID,
+	})
+}
+
+// RemoveCoHost removes a co-host; co-hosts may also step down themselves
+func (s *EventService) RemoveCoHost(eventID, cohostID, userID uuid.UUID, isAdmin bool) error {
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return err
+	}
+
+	if cohostID != userID {
+		if err := s.authorize(event, userID, isAdmin, PermManageCoHosts); err != nil {
+			return err
+		}
+	}
+
+	exists, err := s.cohostRepo.Exists(eventID, cohostID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("user is not a co-host of this event")
+	}
+
+	return s.cohostRepo.Delete(eventID, cohostID)
+}
+
+// TransferOwnership hands the event to another user, optionally keeping the previous owner as co-host
+func (s *EventService) TransferOwnership(eventID, newOwnerID, userID uuid.UUID, isAdmin, keepAsCoHost bool) error {
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorize(event, userID, isAdmin, PermTransferOwnership); err != nil {
+		return err
+	}
+
+	if event.Status == models.EventStatusCancelled {
+		return errors.New("cannot transfer a cancelled event")
+	}
+
+	if event.CreatorID == newOwnerID {
+		return errors.New("user already owns this event")
+	}
+
+	if _, err := s.userRepo.FindByID(newOwnerID); err != nil {
+		return errors.New("user not found")
+	}
+
+	return s.eventRepo.TransferOwnership(event, newOwnerID, keepAsCoHost)
 }
 
 func (s *EventService) ListEvents(filter repositories.EventFilter) ([]map[string]interface{}, int64, error) {
-	return s.eventRepo.List(filter)
+	// Match "Futsal", "futsal" and known aliases against the same catalog slug
+	if filter.SportType != "" {
+		if sport, err := s.sportService.Resolve(filter.SportType); err == nil {
+			filter.SportType = sport.Slug
+		}
+	}
+
+	events, total, err := s.eventRepo.List(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Show each start time in the event's own zone as well
+	for _, row := range events {
+		eventTime, ok := row["event_time"].(time.Time)
+		if !ok {
+			continue
+		}
+		zone, _ := row["time_zone"].(string)
+		if loc, err := timezone.Load(zone); err == nil {
+			row["local_event_time"] = eventTime.In(loc)
+		}
+	}
+	return events, total, nil
 }
 
-func (s *EventService) ListAllEvents(offset, limit int) ([]models.Event, int64, error) {
-	return s.eventRepo.ListAll(offset, limit)
+// ListAllEvents returns every event for admins, optionally only those in one status
+func (s *EventService) ListAllEvents(status string, offset, limit int) ([]models.Event, int64, error) {
+	return s.eventRepo.ListAll(status, offset, limit)
 }
 
-func (s *EventService) UpdateStatus(id uuid.UUID, status string) error {
+// UpdateStatus overrides the event status (admin only) and records the change in
+// the audit log
+func (s *EventService) UpdateStatus(id uuid.UUID, status, reason string, adminID uuid.UUID, meta AuditMeta) error {
 	event, err := s.eventRepo.FindByID(id)
 	if err != nil {
 		return err
 	}
+	previous := event.Status
 
-	if status != "open" && status != "full" && status != "cancelled" {
+	switch status {
+	case models.EventStatusOpen, models.EventStatusFull, models.EventStatusOngoing,
+		models.EventStatusCompleted, models.EventStatusCancelled:
+	default:
 		return errors.New("invalid status")
 	}
 
+	if event.Status == models.EventStatusCancelled {
+		if status == models.EventStatusCancelled {
+			return nil
+		}
+		return errors.New("cancelled events are reinstated with uncancel")
+	}
+	if status == models.EventStatusCancelled {
+		if err := s.cancel(event, adminID, reason); err != nil {
+			return err
+		}
+		s.auditStatus(event, previous, meta)
+		return nil
+	}
+
 	event.Status = status
-	return s.eventRepo.Update(event)
+	if err := s.eventRepo.Update(event); err != nil {
+		return err
+	}
+	s.publishStatus(event)
+	s.auditStatus(event, previous, meta)
+	return nil
+}
+
+func (s *EventService) auditStatus(event *models.Event, previous string, meta AuditMeta) {
+	if event.Status == previous {
+		return
+	}
+	after := map[string]interface{}{"status": event.Status}
+	if event.CancellationReason != nil {
+		after["cancellation_reason"] = *event.CancellationReason
+	}
+	s.auditService.Record(meta, AuditEntry{
+		Action:     models.AuditActionEventStatusSet,
+		TargetType: models.AuditTargetEvent,
+		TargetID:   &event.ID,
+		Before:     map[string]interface{}{"status": previous},
+		After:      after,
+	})
 }
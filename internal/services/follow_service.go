@@ -0,0 +1,150 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+
+	"github.com/google/uuid"
+)
+
+// ErrUserNotFound is returned when the user being looked up does not exist
+var ErrUserNotFound = errors.New("user not found")
+
+// FollowCounts are the sizes of a user's social graph
+type FollowCounts struct {
+	Followers int64 `json:"follower_count"`
+	Following int64 `json:"following_count"`
+}
+
+type FollowService struct {
+	followRepo          *repositories.FollowRepository
+	blockRepo           *repositories.BlockRepository
+	userRepo            *repositories.UserRepository
+	notificationService *NotificationService
+}
+
+func NewFollowService(
+	followRepo *repositories.FollowRepository,
+	blockRepo *repositories.BlockRepository,
+	userRepo *repositories.UserRepository,
+	notificationService *NotificationService,
+) *FollowService {
+	return &FollowService{
+		followRepo:          followRepo,
+		blockRepo:           blockRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Follow makes followerID follow followeeID. Following someone twice is a no-op.
+func (s *FollowService) Follow(followerID, followeeID uuid.UUID) error {
+	if followerID == followeeID {
+		return errors.New("you cannot follow yourself")
+	}
+	if _, err := s.userRepo.FindByID(followeeID); err != nil {
+		return ErrUserNotFound
+	}
+	blocked, err := s.blockRepo.Between(followerID, followeeID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errors.New("you cannot follow this user")
+	}
+
+	created, err := s.followRepo.Create(&models.UserFollow{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	})
+	if err != nil {
+		return err
+	}
+	if created {
+		s.notifyFollowed(followerID, followeeID)
+	}
+	return nil
+}
+
+func (s *FollowService) Unfollow(followerID, followeeID uuid.UUID) error {
+	return s.followRepo.Delete(followerID, followeeID)
+}
+
+func (s *FollowService) IsFollowing(followerID, followeeID uuid.UUID) (bool, error) {
+	return s.followRepo.Exists(followerID, followeeID)
+}
+
+func (s *FollowService) Counts(userID uuid.UUID) (FollowCounts, error) {
+	var counts FollowCounts
+	var err error
+	if counts.Followers, err = s.followRepo.CountFollowers(userID); err != nil {
+		return counts, err
+	}
+	counts.Following, err = s.followRepo.CountFollowing(userID)
+	return counts, err
+}
+
+// ListFollowers returns the users following userID, most recent first
+func (s *FollowService) ListFollowers(userID uuid.UUID, offset, limit int) ([]models.User, int64, error) {
+	if _, err := s.userRepo.FindByID(userID); err != nil {
+		return nil, 0, ErrUserNotFound
+	}
+
+	follows, total, err := s.followRepo.ListFollowers(userID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users := make([]models.User, 0, len(follows))
+	for _, follow := range follows {
+		if follow.Follower != nil {
+			users = append(users, *follow.Follower)
+		}
+	}
+	return users, total, nil
+}
+
+// ListFollowing returns the users userID follows, most recent first
+func (s *FollowService) ListFollowing(userID uuid.UUID, offset, limit int) ([]models.User, int64, error) {
+	if _, err := s.userRepo.FindByID(userID); err != nil {
+		return nil, 0, ErrUserNotFound
+	}
+
+	follows, total, err := s.followRepo.ListFollowing(userID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users := make([]models.User, 0, len(follows))
+	for _, follow := range follows {
+		if follow.Followee != nil {
+			users = append(users, *follow.Followee)
+		}
+	}
+	return users, total, nil
+}
+
+func (s *FollowService) notifyFollowed(followerID, followeeID uuid.UUID) {
+	if s.notificationService == nil {
+		return
+	}
+
+	name := "Someone"
+	if user, err := s.userRepo.FindByID(followerID); err == nil {
+		name = user.Name
+	}
+	err := s.notificationService.Notify([]uuid.UUID{followeeID}, NotificationInput{
+		Type:  models.NotificationNewFollower,
+		Title: "New follower",
+		Body:  fmt.Sprintf("%s started following you.", name),
+		Data: map[string]interface{}{
+			"user_id": followerID,
+		},
+	})
+	if err != nil {
+		log.Printf("notifications: failed to send %s to user %s: %v", models.NotificationNewFollower, followeeID, err)
+	}
+}
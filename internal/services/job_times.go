@@ -0,0 +1,32 @@
+package services
+
+import (
+	"fmt"
+	"playspotter/internal/jobs"
+	"playspotter/internal/models"
+	"time"
+)
+
+// Job payloads carry times as RFC 3339 strings, which keep whole seconds only.
+// Handlers compare a payload time with the stored one using sameSecond, so a time
+// that was not changed still matches after the round trip.
+
+// jobTime formats a time for a job payload
+func jobTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseJobTime reads a time written by jobTime from the job's payload
+func parseJobTime(job *models.Job, key string) (time.Time, error) {
+	value, _ := job.Payload[key].(string)
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid %s", jobs.ErrPermanent, key)
+	}
+	return t, nil
+}
+
+// sameSecond reports whether a stored time still matches the one a job was queued for
+func sameSecond(stored, scheduled time.Time) bool {
+	return stored.Unix() == scheduled.Unix()
+}
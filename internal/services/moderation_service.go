@@ -0,0 +1,439 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrReportNotFound is returned when a report does not exist
+var ErrReportNotFound = errors.New("report not found")
+
+// ErrReportClaimed is returned when another admin is working on the report
+var ErrReportClaimed = errors.New("report is claimed by another admin")
+
+// ModerationDecision is what an admin decided to do about a report
+type ModerationDecision struct {
+	Action string
+	// Note is shown to the user for warnings and suspensions and kept with the action
+	Note string
+	// SuspendUntil is required for suspend_user
+	SuspendUntil *time.Time
+}
+
+type ModerationService struct {
+	reportRepo          *repositories.ReportRepository
+	moderationRepo      *repositories.ModerationRepository
+	eventRepo           *repositories.EventRepository
+	commentRepo         *repositories.CommentRepository
+	eventService        *EventService
+	commentService      *CommentService
+	accountService      *AccountService
+	notificationService *NotificationService
+}
+
+func NewModerationService(
+	reportRepo *repositories.ReportRepository,
+	moderationRepo *repositories.ModerationRepository,
+	eventRepo *repositories.EventRepository,
+	commentRepo *repositories.CommentRepository,
+	eventService *EventService,
+	commentService *CommentService,
+	accountService *AccountService,
+	notificationService *NotificationService,
+) *ModerationService {
+	return &ModerationService{
+		reportRepo:          reportRepo,
+		moderationRepo:      moderationRepo,
+		eventRepo:           eventRepo,
+		commentRepo:         commentRepo,
+		eventService:        eventService,
+		commentService:      commentService,
+		accountService:      accountService,
+		notificationService: notificationService,
+	}
+}
+
+func (s *ModerationService) GetReport(id uuid.UUID) (*models.Report, error) {
+	report, err := s.reportRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrReportNotFound
+	}
+	return report, nil
+}
+
+// ClaimReport marks the admin as working on the report so others leave it alone.
+// Claiming a report the admin already holds is a no-op.
+func (s *ModerationService) ClaimReport(id, adminID uuid.UUID) (*models.Report, error) {
+	report, err := s.GetReport(id)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status == models.ReportStatusClaimed && *report.ClaimedBy == adminID {
+		return report, nil
+	}
+
+	now := time.Now().UTC()
+	claimed, err := s.reportRepo.Claim(id, adminID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, s.reportConflict(id)
+	}
+
+	report.Status = models.ReportStatusClaimed
+	report.ClaimedBy = &adminID
+	report.ClaimedAt = &now
+	return report, nil
+}
+
+// ReleaseReport puts a claimed report back in the queue; only the admin holding it can
+func (s *ModerationService) ReleaseReport(id, adminID uuid.UUID) (*models.Report, error) {
+	report, err := s.GetReport(id)
+	if err != nil {
+		return nil, err
+	}
+
+	released, err := s.reportRepo.Release(id, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if !released {
+		return nil, s.reportConflict(id)
+	}
+
+	report.Status = models.ReportStatusOpen
+	report.ClaimedBy = nil
+	report.ClaimedAt = nil
+	return report, nil
+}
+
+// reportConflict explains why a conditional update left the report unchanged
+func (s *ModerationService) reportConflict(id uuid.UUID) error {
+	report, err := s.GetReport(id)
+	if err != nil {
+		return err
+	}
+
+	switch report.Status {
+	case models.ReportStatusClaimed:
+		return ErrReportClaimed
+	case models.ReportStatusOpen:
+		return errors.New("report is not claimed")
+	}
+	return errors.New("report is already closed")
+}
+
+// ResolveReport takes the decided action and closes the report. Any action but
+// dismiss also closes every other report waiting about the same target. Open
+// reports can be resolved without claiming them first.
+func (s *ModerationService) ResolveReport(id, adminID uuid.UUID, decision ModerationDecision) (*models.Report, error) {
+	report, err := s.GetReport(id)
+	if err != nil {
+		return nil, err
+	}
+
+	decision.Note = strings.TrimSpace(decision.Note)
+	if len(decision.Note) > 1000 {
+		return nil, errors.New("note must be at most 1000 characters")
+	}
+
+	userID, err := s.targetUser(report)
+	if err != nil {
+		return nil, err
+	}
+
+	action := &models.ModerationAction{
+		ReportID:   &report.ID,
+		AdminID:    adminID,
+		Action:     decision.Action,
+		TargetType: report.TargetType,
+		TargetID:   report.TargetID,
+		UserID:     userID,
+	}
+	if decision.Note != "" {
+		action.Note = &decision.Note
+	}
+
+	// Close the report before acting so two admins cannot both act on it; it is
+	// put back as it was when the action fails
+	now := time.Now().UTC()
+	status := models.ReportStatusResolved
+	if decision.Action == models.ModerationActionDismiss {
+		status = models.ReportStatusDismissed
+	}
+	closed, err := s.reportRepo.Resolve(report.ID, adminID, status, decision.Action, now)
+	if err != nil {
+		return nil, err
+	}
+	if !closed {
+		return nil, s.reportConflict(report.ID)
+	}
+
+	if err := s.apply(report, action, decision); err != nil {
+		if restoreErr := s.reportRepo.Update(report); restoreErr != nil {
+			log.Printf("moderation: failed to reopen report %s after a failed action: %v", report.ID, restoreErr)
+		}
+		return nil, err
+	}
+	if err := s.moderationRepo.Create(action); err != nil {
+		return nil, err
+	}
+
+	report.Status = status
+	report.ResolvedBy = &adminID
+	report.ResolvedAt = &now
+	report.Resolution = &decision.Action
+
+	if decision.Action != models.ModerationActionDismiss {
+		if err := s.reportRepo.ResolveOpenForTarget(report.TargetType, report.TargetID, adminID, decision.Action, now); err != nil {
+			log.Printf("moderation: failed to close other reports about %s %s: %v", report.TargetType, report.TargetID, err)
+		}
+	}
+	return report, nil
+}
+
+// apply carries out the decision on the report's target
+func (s *ModerationService) apply(report *models.Report, action *models.ModerationAction, decision ModerationDecision) error {
+	switch decision.Action {
+	case models.ModerationActionDismiss:
+		return nil
+	case models.ModerationActionWarn:
+		if decision.Note == "" {
+			return errors.New("a note is required to warn a user")
+		}
+		if action.UserID == nil {
+			return errors.New("reported item has no author to warn")
+		}
+		s.notifyUser(*action.UserID, NotificationInput{
+			Type:  models.NotificationModeration,
+			Title: "Warning from a moderator",
+			Body:  decision.Note,
+			Data:  moderationData(report, models.ModerationActionWarn),
+		})
+		return nil
+	case models.ModerationActionHideEvent:
+		if report.TargetType != models.ReportTargetEvent {
+			return errors.New("only reported events can be hidden")
+		}
+		_, err := s.eventService.HideEvent(report.TargetID, action.AdminID, noteOr(decision.Note, "Reported as "+report.Category))
+		return err
+	case models.ModerationActionHideComment:
+		if report.TargetType != models.ReportTargetComment {
+			return errors.New("only reported comments can be hidden")
+		}
+		reason := noteOr(decision.Note, "Reported as "+report.Category)
+		if err := s.commentService.HideComment(report.TargetID, action.AdminID, reason); err != nil {
+			return err
+		}
+		if action.UserID != nil {
+			s.notifyUser(*action.UserID, NotificationInput{
+				Type:  models.NotificationModeration,
+				Title: "Comment hidden",
+				Body:  "One of your comments was hidden by a moderator: " + reason,
+				Data:  moderationData(report, models.ModerationActionHideComment),
+			})
+		}
+		return nil
+	case models.ModerationActionSuspendUser:
+		if decision.SuspendUntil == nil {
+			return errors.New("suspend_until is required to suspend a user")
+		}
+		if action.UserID == nil {
+			return errors.New("reported item has no author to suspend")
+		}
+		reason := noteOr(decision.Note, "Reported as "+report.Category)
+		user, err := s.suspend(*action.UserID, action.AdminID, *decision.SuspendUntil, reason)
+		if err != nil {
+			return err
+		}
+		action.SuspendedUntil = user.SuspendedUntil
+		return nil
+	case models.ModerationActionBanUser:
+		if action.UserID == nil {
+			return errors.New("reported item has no author to ban")
+		}
+		_, err := s.ban(*action.UserID, action.AdminID, noteOr(decision.Note, "Reported as "+report.Category))
+		return err
+	}
+	return errors.New("action must be one of warn, hide_event, hide_comment, suspend_user, ban_user, dismiss")
+}
+
+// HideEvent hides an event outside of a report and records the action
+func (s *ModerationService) HideEvent(eventID, adminID uuid.UUID, reason string) (*models.Event, error) {
+	event, err := s.eventService.HideEvent(eventID, adminID, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.record(&models.ModerationAction{
+		AdminID:    adminID,
+		Action:     models.ModerationActionHideEvent,
+		TargetType: models.ReportTargetEvent,
+		TargetID:   event.ID,
+		UserID:     &event.CreatorID,
+		Note:       event.HiddenReason,
+	})
+	return event, nil
+}
+
+// UnhideEvent puts a hidden event back and records the action
+func (s *ModerationService) UnhideEvent(eventID, adminID uuid.UUID) (*models.Event, error) {
+	event, err := s.eventService.UnhideEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	s.record(&models.ModerationAction{
+		AdminID:    adminID,
+		Action:     models.ModerationActionUnhideEvent,
+		TargetType: models.ReportTargetEvent,
+		TargetID:   event.ID,
+		UserID:     &event.CreatorID,
+	})
+	return event, nil
+}
+
+// SuspendUser suspends a user outside of a report and records the action
+func (s *ModerationService) SuspendUser(userID, adminID uuid.UUID, until time.Time, reason string) (*models.User, error) {
+	user, err := s.suspend(userID, adminID, until, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAccount(user, adminID, models.ModerationActionSuspendUser)
+	return user, nil
+}
+
+// BanUser bans a user and records the action
+func (s *ModerationService) BanUser(userID, adminID uuid.UUID, reason string) (*models.User, error) {
+	user, err := s.ban(userID, adminID, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAccount(user, adminID, models.ModerationActionBanUser)
+	return user, nil
+}
+
+// UnbanUser lifts a ban or suspension and records the action with the reason
+func (s *ModerationService) UnbanUser(userID, adminID uuid.UUID, reason string) (*models.User, error) {
+	user, err := s.accountService.Unban(userID, adminID, reason)
+	if err != nil {
+		return nil, err
+	}
+	reason = strings.TrimSpace(reason)
+	s.record(&models.ModerationAction{
+		AdminID:    adminID,
+		Action:     models.ModerationActionUnbanUser,
+		TargetType: models.ReportTargetUser,
+		TargetID:   user.ID,
+		UserID:     &user.ID,
+		Note:       &reason,
+	})
+	return user, nil
+}
+
+// ListActions returns the record of moderation actions, newest first
+func (s *ModerationService) ListActions(filter repositories.ModerationActionFilter) ([]models.ModerationAction, int64, error) {
+	return s.moderationRepo.List(filter)
+}
+
+// suspend suspends the user and tells them until when
+func (s *ModerationService) suspend(userID, adminID uuid.UUID, until time.Time, reason string) (*models.User, error) {
+	user, err := s.accountService.Suspend(userID, adminID, until, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyUser(user.ID, NotificationInput{
+		Type:  models.NotificationModeration,
+		Title: "Account suspended",
+		Body:  fmt.Sprintf("Your account is suspended until %s: %s", user.SuspendedUntil.Format(time.RFC1123), *user.StatusReason),
+		Data:  map[string]interface{}{"action": models.ModerationActionSuspendUser},
+	})
+	return user, nil
+}
+
+// ban bans the user and tells them why
+func (s *ModerationService) ban(userID, adminID uuid.UUID, reason string) (*models.User, error) {
+	user, err := s.accountService.Ban(userID, adminID, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyUser(user.ID, NotificationInput{
+		Type:  models.NotificationModeration,
+		Title: "Account banned",
+		Body:  "Your account has been banned: " + *user.StatusReason,
+		Data:  map[string]interface{}{"action": models.ModerationActionBanUser},
+	})
+	return user, nil
+}
+
+func (s *ModerationService) recordAccount(user *models.User, adminID uuid.UUID, action string) {
+	s.record(&models.ModerationAction{
+		AdminID:        adminID,
+		Action:         action,
+		TargetType:     models.ReportTargetUser,
+		TargetID:       user.ID,
+		UserID:         &user.ID,
+		Note:           user.StatusReason,
+		SuspendedUntil: user.SuspendedUntil,
+	})
+}
+
+// record stores an action taken outside of a report. The action has already
+// happened, so a failure is logged rather than returned.
+func (s *ModerationService) record(action *models.ModerationAction) {
+	if err := s.moderationRepo.Create(action); err != nil {
+		log.Printf("moderation: failed to record %s of %s %s: %v", action.Action, action.TargetType, action.TargetID, err)
+	}
+}
+
+// targetUser returns the user a report is about: the reported user, or the author
+// of the reported event or comment
+func (s *ModerationService) targetUser(report *models.Report) (*uuid.UUID, error) {
+	switch report.TargetType {
+	case models.ReportTargetUser:
+		return &report.TargetID, nil
+	case models.ReportTargetEvent:
+		event, err := s.eventRepo.FindByID(report.TargetID)
+		if err != nil {
+			return nil, ErrReportTargetNotFound
+		}
+		return &event.CreatorID, nil
+	case models.ReportTargetComment:
+		comment, err := s.commentRepo.FindByID(report.TargetID)
+		if err != nil {
+			return nil, ErrReportTargetNotFound
+		}
+		return &comment.UserID, nil
+	}
+	return nil, nil
+}
+
+func (s *ModerationService) notifyUser(userID uuid.UUID, input NotificationInput) {
+	if s.notificationService == nil {
+		return
+	}
+	if err := s.notificationService.Notify([]uuid.UUID{userID}, input); err != nil {
+		log.Printf("notifications: failed to send %s to user %s: %v", input.Type, userID, err)
+	}
+}
+
+func moderationData(report *models.Report, action string) map[string]interface{} {
+	return map[string]interface{}{
+		"action":      action,
+		"target_type": report.TargetType,
+		"target_id":   report.TargetID,
+	}
+}
+
+func noteOr(note, fallback string) string {
+	if note == "" {
+		return fallback
+	}
+	return note
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationService struct {
+	notificationRepo *repositories.NotificationRepository
+	pushService      *PushService
+}
+
+func NewNotificationService(notificationRepo *repositories.NotificationRepository, pushService *PushService) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		pushService:      pushService,
+	}
+}
+
+// NotificationInput is the content of a notification sent to one or more users
+type NotificationInput struct {
+	Type    string
+	EventID *uuid.UUID
+	Title   string
+	Body    string
+	Data    map[string]interface{}
+}
+
+// Notify stores the notification in the inbox of every user who has not switched
+// its type off, and pushes it to the devices of those who want it pushed.
+// Duplicate user IDs are notified once.
+func (s *NotificationService) Notify(userIDs []uuid.UUID, input NotificationInput) error {
+	if !validNotificationType(input.Type) {
+		return errors.New("unknown notification type")
+	}
+
+	recipients := uniqueUserIDs(userIDs)
+	prefs, err := s.notificationRepo.PreferencesFor(input.Type, recipients)
+	if err != nil {
+		return err
+	}
+
+	data := input.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	notifications := make([]models.Notification, 0, len(recipients))
+	var pushRecipients []uuid.UUID
+	for _, userID := range recipients {
+		pref, ok := prefs[userID]
+		if !ok {
+			pref = defaultPreference(userID, input.Type)
+		}
+
+		if pref.InApp {
+			notifications = append(notifications, models.Notification{
+				UserID:  userID,
+				Type:    input.Type,
+				EventID: input.EventID,
+				Title:   input.Title,
+				Body:    input.Body,
+				Data:    data,
+			})
+		}
+		if pref.Push {
+			pushRecipients = append(pushRecipients, userID)
+		}
+	}
+
+	if err := s.notificationRepo.CreateBatch(notifications); err != nil {
+		return err
+	}
+
+	if s.pushService != nil && len(pushRecipients) > 0 {
+		pushData := map[string]string{"type": input.Type}
+		if input.EventID != nil {
+			pushData["event_id"] = input.EventID.String()
+		}
+		s.pushService.Send(pushRecipients, input.Title, input.Body, pushData)
+	}
+	return nil
+}
+
+func (s *NotificationService) List(userID uuid.UUID, unreadOnly bool, offset, limit int) ([]models.Notification, int64, error) {
+	return s.notificationRepo.ListByUser(userID, unreadOnly, offset, limit)
+}
+
+func (s *NotificationService) UnreadCount(userID uuid.UUID) (int64, error) {
+	return s.notificationRepo.CountUnread(userID)
+}
+
+// MarkRead marks the listed notifications as read, or all of them when ids is empty
+func (s *NotificationService) MarkRead(userID uuid.UUID, ids []uuid.UUID) (int64, error) {
+	return s.notificationRepo.MarkRead(userID, ids)
+}
+
+// Preferences returns the user's setting for every notification type
+func (s *NotificationService) Preferences(userID uuid.UUID) ([]models.NotificationPreference, error) {
+	stored, err := s.notificationRepo.ListPreferences(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[string]models.NotificationPreference, len(stored))
+	for _, pref := range stored {
+		byType[pref.Type] = pref
+	}
+
+	prefs := make([]models.NotificationPreference, 0, len(models.NotificationTypes))
+	for _, t := range models.NotificationTypes {
+		pref, ok := byType[t]
+		if !ok {
+			pref = defaultPreference(userID, t)
+		}
+		prefs = append(prefs, pref)
+	}
+	return prefs, nil
+}
+
+// SetPreferences switches inbox and push delivery of notification types on or off;
+// types or channels left out keep their current setting
+func (s *NotificationService) SetPreferences(userID uuid.UUID, inApp, push map[string]bool) ([]models.NotificationPreference, error) {
+	for t := range inApp {
+		if !validNotificationType(t) {
+			return nil, errors.New("unknown notification type: " + t)
+		}
+	}
+	for t := range push {
+		if !validNotificationType(t) {
+			return nil, errors.New("unknown notification type: " + t)
+		}
+	}
+
+	current, err := s.Preferences(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var changed []models.NotificationPreference
+	for _, pref := range current {
+		enabled, inAppSet := inApp[pref.Type]
+		pushEnabled, pushSet := push[pref.Type]
+		if !inAppSet && !pushSet {
+			continue
+		}
+		if inAppSet {
+			pref.InApp = enabled
+		}
+		if pushSet {
+			pref.Push = pushEnabled
+		}
+		pref.UserID = userID
+		pref.UpdatedAt = now
+		changed = append(changed, pref)
+	}
+
+	if err := s.notificationRepo.SavePreferences(changed); err != nil {
+		return nil, err
+	}
+	return s.Preferences(userID)
+}
+
+func defaultPreference(userID uuid.UUID, notificationType string) models.NotificationPreference {
+	return models.NotificationPreference{
+		UserID: userID,
+		Type:   notificationType,
+		InApp:  true,
+		Push:   models.PushByDefault(notificationType),
+	}
+}
+
+func validNotificationType(t string) bool {
+	for _, known := range models.NotificationTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueUserIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if id == uuid.Nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
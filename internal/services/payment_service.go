@@ -0,0 +1,252 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"playspotter/internal/models"
+	"playspotter/internal/payments"
+	"playspotter/internal/repositories"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrPaymentsDisabled is returned for paid actions when no payment provider is configured
+var ErrPaymentsDisabled = errors.New("payments are not enabled on this server")
+
+type PaymentService struct {
+	paymentRepo *repositories.PaymentRepository
+	provider    payments.PaymentProvider
+	timeout     time.Duration
+}
+
+// NewPaymentService creates the payment service; provider is nil when payments are disabled
+func NewPaymentService(paymentRepo *repositories.PaymentRepository, provider payments.PaymentProvider, timeout time.Duration) *PaymentService {
+	return &PaymentService{
+		paymentRepo: paymentRepo,
+		provider:    provider,
+		timeout:     timeout,
+	}
+}
+
+// Enabled reports whether a payment provider is configured
+func (s *PaymentService) Enabled() bool {
+	return s.provider != nil
+}
+
+// Timeout is how long a spot stays reserved while the user completes payment
+func (s *PaymentService) Timeout() time.Duration {
+	return s.timeout
+}
+
+// StartPayment opens a payment intent with the provider for the event's current price
+func (s *PaymentService) StartPayment(event *models.Event, userID uuid.UUID) (*models.Payment, error) {
+	if !s.Enabled() {
+		return nil, ErrPaymentsDisabled
+	}
+	if err := s.paymentRepo.ExpireStale(event.ID, userID); err != nil {
+		return nil, err
+	}
+
+	payment := &models.Payment{
+		ID:          uuid.New(),
+		EventID:     event.ID,
+		UserID:      userID,
+		Provider:    s.provider.Name(),
+		AmountCents: event.PriceCents,
+		Currency:    event.Currency,
+		Status:      models.PaymentStatusPending,
+		ExpiresAt:   time.Now().UTC().Add(s.timeout),
+	}
+
+	intent, err := s.provider.CreateIntent(payments.IntentRequest{
+		Reference:   payment.ID.String(),
+		AmountCents: payment.AmountCents,
+		Currency:    payment.Currency,
+		Description: event.Title,
+		ExpiresAt:   payment.ExpiresAt,
+	})
+	if err != nil {
+		return nil, errors.New("could not start payment, please try again")
+	}
+
+	payment.ProviderRef = intent.ProviderRef
+	if intent.CheckoutURL != "" {
+		payment.CheckoutURL = &intent.CheckoutURL
+	}
+
+	if err := s.paymentRepo.Create(payment); err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+// ActivePayment returns the user's pending payment for the event, if any
+func (s *PaymentService) ActivePayment(eventID, userID uuid.UUID) (*models.Payment, error) {
+	return s.paymentRepo.FindActive(eventID, userID)
+}
+
+// CancelPending closes the user's open checkout for the event with the provider. A
+// payment that completes anyway is refunded when its webhook arrives.
+func (s *PaymentService) CancelPending(eventID, userID uuid.UUID) error {
+	payment, err := s.paymentRepo.FindActive(eventID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if s.Enabled() {
+		if err := s.provider.CancelIntent(payment.ProviderRef); err != nil {
+			return err
+		}
+	}
+
+	payment.Status = models.PaymentStatusExpired
+	return s.paymentRepo.Update(payment)
+}
+
+// Refund returns a successful payment. A provider failure is recorded as
+// refund_failed and returned, so the caller can retry it later.
+func (s *PaymentService) Refund(payment *models.Payment) error {
+	if !refundable(payment) {
+		return nil
+	}
+
+	var refundErr error
+	if s.Enabled() {
+		refundErr = RefundPayment(s.provider, payment, time.Now().UTC())
+	} else {
+		payment.Status = models.PaymentStatusRefundFailed
+		refundErr = ErrPaymentsDisabled
+	}
+
+	if err := s.paymentRepo.Update(payment); err != nil {
+		return err
+	}
+	return refundErr
+}
+
+// RefundEvent refunds every paid participant of a cancelled event, retrying refunds
+// that failed before, and closes open checkouts. It fails when any refund failed.
+func (s *PaymentService) RefundEvent(eventID uuid.UUID) error {
+	if err := s.paymentRepo.ExpireAllPending(eventID); err != nil {
+		return err
+	}
+
+	paid, err := s.paymentRepo.ListByEvent(eventID, models.PaymentStatusSucceeded, models.PaymentStatusRefundFailed)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for i := range paid {
+		if err := s.Refund(&paid[i]); err != nil {
+			log.Printf("payments: refund of payment %s failed: %v", paid[i].ID, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d refunds failed", failed, len(paid))
+	}
+	return nil
+}
+
+// RefundPayment asks the provider to refund the payment and records the outcome on
+// it; payments that are not paid, or already refunded, are left alone
+func RefundPayment(provider payments.PaymentProvider, payment *models.Payment, now time.Time) error {
+	if !refundable(payment) {
+		return nil
+	}
+
+	if err := provider.Refund(payment.ProviderRef, payment.AmountCents); err != nil {
+		payment.Status = models.PaymentStatusRefundFailed
+		return err
+	}
+
+	payment.Status = models.PaymentStatusRefunded
+	payment.RefundedAt = &now
+	return nil
+}
+
+// RefundablePayments returns the user's payments for the event that are paid and not
+// yet refunded
+func (s *PaymentService) RefundablePayments(eventID, userID uuid.UUID) ([]models.Payment, error) {
+	return s.paymentRepo.ListByUser(eventID, userID, models.PaymentStatusSucceeded, models.PaymentStatusRefundFailed)
+}
+
+func refundable(payment *models.Payment) bool {
+	return payment.Status == models.PaymentStatusSucceeded || payment.Status == models.PaymentStatusRefundFailed
+}
+
+// RefundedUserIDs returns the users whose payments for the event were refunded
+// and who have not paid for it again since
+func (s *PaymentService) RefundedUserIDs(eventID uuid.UUID) ([]uuid.UUID, error) {
+	payments, err := s.paymentRepo.ListByEvent(eventID, models.PaymentStatusRefunded, models.PaymentStatusSucceeded)
+	if err != nil {
+		return nil, err
+	}
+
+	// Payments are in creation order, so the last one per user wins
+	refunded := make(map[uuid.UUID]bool)
+	var order []uuid.UUID
+	for _, payment := range payments {
+		if _, seen := refunded[payment.UserID]; !seen {
+			order = append(order, payment.UserID)
+		}
+		refunded[payment.UserID] = payment.Status == models.PaymentStatusRefunded
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(order))
+	for _, id := range order {
+		if refunded[id] {
+			userIDs = append(userIDs, id)
+		}
+	}
+	return userIDs, nil
+}
+
+// ParseWebhook verifies a provider callback
+func (s *PaymentService) ParseWebhook(payload []byte, header http.Header) (*payments.WebhookEvent, error) {
+	if !s.Enabled() {
+		return nil, ErrPaymentsDisabled
+	}
+	return s.provider.ParseWebhook(payload, header)
+}
+
+// ProcessWebhook records the callback and runs apply in the same transaction, so each
+// delivery is applied once even when retries arrive concurrently. Deliveries that were
+// already processed are acknowledged without calling apply.
+func (s *PaymentService) ProcessWebhook(event *payments.WebhookEvent, apply func(tx *gorm.DB) error) error {
+	return s.paymentRepo.ProcessWebhook(&models.PaymentWebhookEvent{
+		Provider:   s.provider.Name(),
+		ExternalID: event.ID,
+		Type:       event.Type,
+	}, apply)
+}
+
+// withTx returns a copy of the service whose queries run in the given transaction
+func (s *PaymentService) withTx(tx *gorm.DB) *PaymentService {
+	scoped := *s
+	scoped.paymentRepo = s.paymentRepo.WithTx(tx)
+	return &scoped
+}
+
+func (s *PaymentService) FindByID(id uuid.UUID) (*models.Payment, error) {
+	return s.paymentRepo.FindByID(id)
+}
+
+func (s *PaymentService) FindByProviderRef(providerRef string) (*models.Payment, error) {
+	if !s.Enabled() {
+		return nil, ErrPaymentsDisabled
+	}
+	return s.paymentRepo.FindByProviderRef(s.provider.Name(), providerRef)
+}
+
+func (s *PaymentService) Update(payment *models.Payment) error {
+	return s.paymentRepo.Update(payment)
+}
@@ -0,0 +1,79 @@
+package services_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"playspotter/internal/models"
+	"playspotter/internal/payments"
+	"playspotter/internal/services"
+)
+
+// flakyProvider fails the first refunds it is asked for, then succeeds
+type flakyProvider struct {
+	failures int
+	refunds  int
+}
+
+func (p *flakyProvider) Name() string { return "flaky" }
+
+func (p *flakyProvider) CreateIntent(req payments.IntentRequest) (*payments.Intent, error) {
+	return &payments.Intent{ProviderRef: "ref"}, nil
+}
+
+func (p *flakyProvider) CancelIntent(providerRef string) error { return nil }
+
+func (p *flakyProvider) Refund(providerRef string, amountCents int) error {
+	p.refunds++
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("provider unavailable")
+	}
+	return nil
+}
+
+func (p *flakyProvider) ParseWebhook(payload []byte, header http.Header) (*payments.WebhookEvent, error) {
+	return nil, payments.ErrInvalidSignature
+}
+
+func TestRefundPaymentRetriesAfterFailure(t *testing.T) {
+	provider := &flakyProvider{failures: 1}
+	payment := &models.Payment{ProviderRef: "ref", AmountCents: 1500, Status: models.PaymentStatusSucceeded}
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	if err := services.RefundPayment(provider, payment, now); err == nil {
+		t.Fatal("Expected the first refund to fail")
+	}
+	if payment.Status != models.PaymentStatusRefundFailed || payment.RefundedAt != nil {
+		t.Fatalf("Expected refund_failed without a refund time, got %s", payment.Status)
+	}
+
+	if err := services.RefundPayment(provider, payment, now); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+	if payment.Status != models.PaymentStatusRefunded || payment.RefundedAt == nil || !payment.RefundedAt.Equal(now) {
+		t.Fatalf("Expected refunded at %v, got %s at %v", now, payment.Status, payment.RefundedAt)
+	}
+
+	if err := services.RefundPayment(provider, payment, now); err != nil {
+		t.Fatalf("Expected a refunded payment to be skipped, got %v", err)
+	}
+	if provider.refunds != 2 {
+		t.Errorf("Expected 2 provider refunds, got %d", provider.refunds)
+	}
+}
+
+func TestRefundPaymentSkipsUnpaid(t *testing.T) {
+	provider := &flakyProvider{}
+	for _, status := range []string{models.PaymentStatusPending, models.PaymentStatusFailed, models.PaymentStatusExpired} {
+		payment := &models.Payment{Status: status}
+		if err := services.RefundPayment(provider, payment, time.Now()); err != nil || payment.Status != status {
+			t.Errorf("Expected %s payment to be left alone, got %s (%v)", status, payment.Status, err)
+		}
+	}
+	if provider.refunds != 0 {
+		t.Errorf("Expected no provider refunds, got %d", provider.refunds)
+	}
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProfileSkill is a self-assessed level shown on a profile
+type ProfileSkill struct {
+	SportType string `json:"sport_type"`
+	Level     int    `json:"level"`
+}
+
+// ProfileStats sums up a user's history on the platform
+type ProfileStats struct {
+	EventsAttended int64 `json:"events_attended"`
+	EventsHosted   int64 `json:"events_hosted"`
+}
+
+// PublicProfile is what other users may see of a user. It never includes the email.
+// When the privacy settings hide the profile from the viewer only the name, avatar
+// and follower counts are filled in and Restricted is set.
+type PublicProfile struct {
+	ID             uuid.UUID      `json:"id"`
+	Name           string         `json:"name"`
+	AvatarURL      *string        `json:"avatar_url,omitempty"`
+	Restricted     bool           `json:"restricted"`
+	Bio            *string        `json:"bio,omitempty"`
+	FavoriteSports []string       `json:"favorite_sports,omitempty"`
+	Skills         []ProfileSkill `json:"skills,omitempty"`
+	HomeArea       *string        `json:"home_area,omitempty"`
+	Stats          *ProfileStats  `json:"stats,omitempty"`
+	FollowCounts
+	IsFollowing *bool     `json:"is_following,omitempty"`
+	MemberSince time.Time `json:"member_since"`
+}
+
+type ProfileService struct {
+	userRepo        *repositories.UserRepository
+	skillRepo       *repositories.SkillRepository
+	eventRepo       *repositories.EventRepository
+	participantRepo *repositories.ParticipantRepository
+	followService   *FollowService
+}
+
+func NewProfileService(
+	userRepo *repositories.UserRepository,
+	skillRepo *repositories.SkillRepository,
+	eventRepo *repositories.EventRepository,
+	participantRepo *repositories.ParticipantRepository,
+	followService *FollowService,
+) *ProfileService {
+	return &ProfileService{
+		userRepo:        userRepo,
+		skillRepo:       skillRepo,
+		eventRepo:       eventRepo,
+		participantRepo: participantRepo,
+		followService:   followService,
+	}
+}
+
+// GetProfile returns the user's profile as the viewer may see it. Users always see
+// their own full profile, and so do admins.
+func (s *ProfileService) GetProfile(id uuid.UUID, viewerID *uuid.UUID, isAdmin bool) (*PublicProfile, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	profile := &PublicProfile{
+		ID:          user.ID,
+		Name:        user.Name,
+		AvatarURL:   user.AvatarURL,
+		MemberSince: user.CreatedAt,
+	}
+	if profile.FollowCounts, err = s.followService.Counts(id); err != nil {
+		return nil, err
+	}
+
+	self := viewerID != nil && *viewerID == id
+	following := false
+	if viewerID != nil && !self {
+		if following, err = s.followService.IsFollowing(*viewerID, id); err != nil {
+			return nil, err
+		}
+		profile.IsFollowing = &following
+	}
+
+	full := self || isAdmin
+	if !full && !canViewProfile(user, following) {
+		profile.Restricted = true
+		return profile, nil
+	}
+
+	profile.Bio = user.Bio
+	profile.FavoriteSports = user.FavoriteSports
+
+	skills, err := s.skillRepo.ListByUser(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, skill := range skills {
+		profile.Skills = append(profile.Skills, ProfileSkill{SportType: skill.SportType, Level: skill.Level})
+	}
+
+	if full || user.ShowHomeArea {
+		profile.HomeArea = user.HomeArea
+	}
+	if full || user.ShowStats {
+		stats := &ProfileStats{}
+		if stats.EventsAttended, err = s.participantRepo.CountAttended(id); err != nil {
+			return nil, err
+		}
+		if stats.EventsHosted, err = s.eventRepo.CountHosted(id); err != nil {
+			return nil, err
+		}
+		profile.Stats = stats
+	}
+	return profile, nil
+}
+
+// canViewProfile applies the user's profile visibility to another viewer
+func canViewProfile(user *models.User, following bool) bool {
+	switch user.ProfileVisibility {
+	case models.ProfileVisibilityFollowers:
+		return following
+	case models.ProfileVisibilityPrivate:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"playspotter/internal/models"
+	"playspotter/internal/push"
+	"playspotter/internal/repositories"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrDeviceNotFound is returned when a device is not registered to the user
+var ErrDeviceNotFound = errors.New("device not found")
+
+const maxDeviceTokenLength = 4096
+
+type PushService struct {
+	deviceRepo *repositories.DeviceRepository
+	sender     push.PushSender
+	policy     push.RetryPolicy
+	inFlight   sync.WaitGroup
+}
+
+func NewPushService(deviceRepo *repositories.DeviceRepository, sender push.PushSender, policy push.RetryPolicy) *PushService {
+	return &PushService{
+		deviceRepo: deviceRepo,
+		sender:     sender,
+		policy:     policy,
+	}
+}
+
+// RegisterDevice stores the device's push token for the user
+func (s *PushService) RegisterDevice(userID uuid.UUID, token, platform string) (*models.DeviceToken, error) {
+	token = strings.TrimSpace(token)
+	if token == "" || len(token) > maxDeviceTokenLength {
+		return nil, errors.New("invalid device token")
+	}
+	switch platform {
+	case models.DevicePlatformIOS, models.DevicePlatformAndroid, models.DevicePlatformWeb:
+	default:
+		return nil, errors.New("platform must be ios, android or web")
+	}
+
+	now := time.Now().UTC()
+	device := &models.DeviceToken{
+		UserID:     userID,
+		Token:      token,
+		Platform:   platform,
+		LastSeenAt: now,
+	}
+	if err := s.deviceRepo.Upsert(device); err != nil {
+		return nil, err
+	}
+	return s.deviceRepo.FindByToken(token)
+}
+
+func (s *PushService) ListDevices(userID uuid.UUID) ([]models.DeviceToken, error) {
+	return s.deviceRepo.ListByUser(userID)
+}
+
+func (s *PushService) RemoveDevice(userID, deviceID uuid.UUID) error {
+	deleted, err := s.deviceRepo.Delete(userID, deviceID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrDeviceNotFound
+	}
+	return nil
+}
+
+// Send pushes the message to every device of the users in the background.
+// Tokens the provider rejects as invalid are removed.
+func (s *PushService) Send(userIDs []uuid.UUID, title, body string, data map[string]string) {
+	devices, err := s.deviceRepo.ListByUsers(userIDs)
+	if err != nil {
+		log.Printf("push: failed to list devices: %v", err)
+		return
+	}
+	if len(devices) == 0 {
+		return
+	}
+
+	s.inFlight.Add(1)
+	go func() {
+		defer s.inFlight.Done()
+
+		for _, device := range devices {
+			msg := push.Message{Token: device.Token, Title: title, Body: body, Data: data}
+			err := push.Deliver(context.Background(), s.sender, msg, s.policy)
+			switch {
+			case err == nil:
+			case errors.Is(err, push.ErrInvalidToken):
+				if err := s.deviceRepo.DeleteByToken(device.Token); err != nil {
+					log.Printf("push: failed to prune device %s: %v", device.ID, err)
+				}
+			default:
+				log.Printf("push: delivery to device %s via %s failed: %v", device.ID, s.sender.Name(), err)
+			}
+		}
+	}()
+}
+
+// Wait blocks until background deliveries have finished; used on shutdown
+func (s *PushService) Wait() {
+	s.inFlight.Wait()
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"strings"
+)
+
+// ErrAlreadyReported is returned when the reporter still has an open report about the target
+var ErrAlreadyReported = errors.New("you already reported this and it is waiting for review")
+
+// ErrReportTargetNotFound is returned when the reported user, event or comment does not exist
+var ErrReportTargetNotFound = errors.New("reported item not found")
+
+type ReportService struct {
+	reportRepo  *repositories.ReportRepository
+	userRepo    *repositories.UserRepository
+	eventRepo   *repositories.EventRepository
+	commentRepo *repositories.CommentRepository
+}
+
+func NewReportService(
+	reportRepo *repositories.ReportRepository,
+	userRepo *repositories.UserRepository,
+	eventRepo *repositories.EventRepository,
+	commentRepo *repositories.CommentRepository,
+) *ReportService {
+	return &ReportService{
+		reportRepo:  reportRepo,
+		userRepo:    userRepo,
+		eventRepo:   eventRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+// CreateReport files a report into the admin moderation queue
+func (s *ReportService) CreateReport(report *models.Report) error {
+	if !validReportCategory(report.Category) {
+		return errors.New("category must be one of " + strings.Join(models.ReportCategories, ", "))
+	}
+	if report.Details != nil {
+		details := strings.TrimSpace(*report.Details)
+		report.Details = &details
+		if details == "" {
+			report.Details = nil
+		}
+	}
+
+	if err := s.checkTarget(report); err != nil {
+		return err
+	}
+
+	if _, err := s.reportRepo.FindOpen(report.ReporterID, report.TargetType, report.TargetID); err == nil {
+		return ErrAlreadyReported
+	}
+
+	report.Status = models.ReportStatusOpen
+	return s.reportRepo.Create(report)
+}
+
+// ListReports returns the moderation queue, oldest first
+func (s *ReportService) ListReports(filter repositories.ReportFilter) ([]models.Report, int64, error) {
+	return s.reportRepo.List(filter)
+}
+
+// checkTarget makes sure the reported item exists and is not the reporter's own
+func (s *ReportService) checkTarget(report *models.Report) error {
+	switch report.TargetType {
+	case models.ReportTargetUser:
+		if report.TargetID == report.ReporterID {
+			return errors.New("you cannot report yourself")
+		}
+		if _, err := s.userRepo.FindByID(report.TargetID); err != nil {
+			return ErrReportTargetNotFound
+		}
+	case models.ReportTargetEvent:
+		event, err := s.eventRepo.FindByID(report.TargetID)
+		if err != nil || event.Status == models.EventStatusDraft {
+			return ErrReportTargetNotFound
+		}
+		if event.CreatorID == report.ReporterID {
+			return errors.New("you cannot report your own event")
+		}
+	case models.ReportTargetComment:
+		comment, err := s.commentRepo.FindByID(report.TargetID)
+		if err != nil || comment.DeletedAt != nil {
+			return ErrReportTargetNotFound
+		}
+		if comment.UserID == report.ReporterID {
+			return errors.New("you cannot report your own comment")
+		}
+	default:
+		return errors.New("target type must be user, event or comment")
+	}
+	return nil
+}
+
+func validReportCategory(category string) bool {
+	for _, c := range models.ReportCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
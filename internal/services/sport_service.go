@@ -0,0 +1,194 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type SportService struct {
+	sportRepo *repositories.SportRepository
+}
+
+func NewSportService(sportRepo *repositories.SportRepository) *SportService {
+	return &SportService{
+		sportRepo: sportRepo,
+	}
+}
+
+func (s *SportService) ListSports(includeInactive bool) ([]models.Sport, error) {
+	return s.sportRepo.List(includeInactive)
+}
+
+func (s *SportService) GetSport(id uuid.UUID) (*models.Sport, error) {
+	return s.sportRepo.FindByID(id)
+}
+
+// Resolve maps free-text input ("Futsal", "futsal 5v5", "futsal") to an active catalog entry
+// by matching the slug, any localized name or any alias, ignoring case and extra whitespace.
+func (s *SportService) Resolve(input string) (*models.Sport, error) {
+	key := normalizeSportKey(input)
+	if key == "" {
+		return nil, errors.New("sport type is required")
+	}
+
+	sports, err := s.sportRepo.List(false)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sports {
+		if sportMatches(&sports[i], key) {
+			return &sports[i], nil
+		}
+	}
+
+	return nil, errors.New("unknown sport type")
+}
+
+func (s *SportService) CreateSport(sport *models.Sport) error {
+	if err := s.validate(sport); err != nil {
+		return err
+	}
+
+	if _, err := s.sportRepo.FindBySlug(sport.Slug); err == nil {
+		return errors.New("sport slug already exists")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	sport.IsActive = true
+	return s.sportRepo.Create(sport)
+}
+
+func (s *SportService) UpdateSport(id uuid.UUID, updates *models.Sport, isActive *bool) (*models.Sport, error) {
+	sport, err := s.sportRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if updates.Slug != "" && updates.Slug != sport.Slug {
+		if _, err := s.sportRepo.FindBySlug(updates.Slug); err == nil {
+			return nil, errors.New("sport slug already exists")
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		sport.Slug = updates.Slug
+	}
+	if updates.Names != nil {
+		sport.Names = updates.Names
+	}
+	if updates.Icon != nil {
+		sport.Icon = updates.Icon
+	}
+	if updates.DefaultTeamSize != nil {
+		sport.DefaultTeamSize = updates.DefaultTeamSize
+	}
+	if updates.Aliases != nil {
+		sport.Aliases = updates.Aliases
+	}
+	if isActive != nil {
+		sport.IsActive = *isActive
+	}
+
+	if err := s.validate(sport); err != nil {
+		return nil, err
+	}
+
+	if err := s.sportRepo.Update(sport); err != nil {
+		return nil, err
+	}
+	return sport, nil
+}
+
+// DeleteSport removes a catalog entry that no event uses; used entries should be deactivated instead
+func (s *SportService) DeleteSport(id uuid.UUID) error {
+	sport, err := s.sportRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	count, err := s.sportRepo.CountEvents(sport.Slug)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("sport is used by existing events, deactivate it instead")
+	}
+
+	return s.sportRepo.Delete(id)
+}
+
+func (s *SportService) validate(sport *models.Sport) error {
+	if !slugPattern.MatchString(sport.Slug) {
+		return errors.New("slug must contain only lowercase letters, digits and dashes")
+	}
+	if strings.TrimSpace(sport.Names["en"]) == "" {
+		return errors.New("an English name is required")
+	}
+	if sport.DefaultTeamSize != nil && *sport.DefaultTeamSize < 1 {
+		return errors.New("default team size must be at least 1")
+	}
+
+	// Store aliases normalized so lookups stay a plain comparison
+	aliases := make([]string, 0, len(sport.Aliases))
+	seen := make(map[string]bool)
+	for _, alias := range sport.Aliases {
+		key := normalizeSportKey(alias)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		aliases = append(aliases, key)
+	}
+	sport.Aliases = aliases
+
+	// Aliases must not point at another sport, otherwise Resolve becomes ambiguous
+	others, err := s.sportRepo.List(true)
+	if err != nil {
+		return err
+	}
+	for i := range others {
+		if others[i].ID == sport.ID {
+			continue
+		}
+		if sportMatches(&others[i], sport.Slug) {
+			return errors.New("slug conflicts with sport " + others[i].Slug)
+		}
+		for _, alias := range aliases {
+			if sportMatches(&others[i], alias) {
+				return errors.New("alias '" + alias + "' is already used by sport " + others[i].Slug)
+			}
+		}
+	}
+
+	return nil
+}
+
+func sportMatches(sport *models.Sport, key string) bool {
+	if sport.Slug == key || normalizeSportKey(strings.ReplaceAll(sport.Slug, "-", " ")) == key {
+		return true
+	}
+	for _, name := range sport.Names {
+		if normalizeSportKey(name) == key {
+			return true
+		}
+	}
+	for _, alias := range sport.Aliases {
+		if alias == key {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeSportKey(value string) string {
+	return strings.ToLower(strings.Join(strings.Fields(value), " "))
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// TeamPlayer is a participant with the strength used for balancing
+type TeamPlayer struct {
+	UserID uuid.UUID
+	Score  float64
+}
+
+// TeamConstraints lists players that must share a team and pairs that must not
+type TeamConstraints struct {
+	Together [][]uuid.UUID
+	Apart    [][]uuid.UUID
+}
+
+type playerGroup struct {
+	members []TeamPlayer
+	score   float64
+}
+
+type teamDraft struct {
+	members []TeamPlayer
+	locked  map[uuid.UUID]bool
+	score   float64
+}
+
+// BalanceTeams splits players into teamCount teams whose sizes differ by at most one
+// (when constraints allow) and whose total scores are as close as practical. Players
+// locked together are placed as a unit; players kept apart never share a team.
+func BalanceTeams(players []TeamPlayer, teamCount int, constraints TeamConstraints) ([][]TeamPlayer, error) {
+	if teamCount < 2 {
+		return nil, errors.New("team count must be at least 2")
+	}
+	if len(players) < teamCount {
+		return nil, errors.New("not enough participants for the requested number of teams")
+	}
+
+	byID := make(map[uuid.UUID]TeamPlayer, len(players))
+	for _, p := range players {
+		byID[p.UserID] = p
+	}
+
+	// Union players that must play together
+	parent := make(map[uuid.UUID]uuid.UUID, len(players))
+	var find func(uuid.UUID) uuid.UUID
+	find = func(id uuid.UUID) uuid.UUID {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+	for _, p := range players {
+		parent[p.UserID] = p.UserID
+	}
+	for _, set := range constraints.Together {
+		for i, id := range set {
+			if _, ok := byID[id]; !ok {
+				return nil, errors.New("locked player " + id.String() + " is not a participant")
+			}
+			if i > 0 {
+				parent[find(id)] = find(set[0])
+			}
+		}
+	}
+
+	apart := make(map[uuid.UUID]map[uuid.UUID]bool)
+	for _, pair := range constraints.Apart {
+		for _, id := range pair {
+			if _, ok := byID[id]; !ok {
+				return nil, errors.New("separated player " + id.String() + " is not a participant")
+			}
+		}
+		for i := range pair {
+			for j := range pair {
+				if i == j {
+					continue
+				}
+				if find(pair[i]) == find(pair[j]) {
+					return nil, errors.New("players cannot be locked together and kept apart")
+				}
+				if apart[pair[i]] == nil {
+					apart[pair[i]] = make(map[uuid.UUID]bool)
+				}
+				apart[pair[i]][pair[j]] = true
+			}
+		}
+	}
+
+	groupIndex := make(map[uuid.UUID]int)
+	var groups []playerGroup
+	for _, p := range players {
+		root := find(p.UserID)
+		idx, ok := groupIndex[root]
+		if !ok {
+			idx = len(groups)
+			groupIndex[root] = idx
+			groups = append(groups, playerGroup{})
+		}
+		groups[idx].members = append(groups[idx].members, p)
+		groups[idx].score += p.Score
+	}
+
+	maxSize := int(math.Ceil(float64(len(players)) / float64(teamCount)))
+	for _, g := range groups {
+		if len(g.members) > maxSize {
+			return nil, errors.New("a locked group is larger than a team")
+		}
+	}
+
+	// Place large and strong groups first so the rest can even things out
+	sort.SliceStable(groups, func(i, j int) bool {
+		if len(groups[i].members) != len(groups[j].members) {
+			return len(groups[i].members) > len(groups[j].members)
+		}
+		if groups[i].score != groups[j].score {
+			return groups[i].score > groups[j].score
+		}
+		return groups[i].members[0].UserID.String() < groups[j].members[0].UserID.String()
+	})
+
+	teams := make([]teamDraft, teamCount)
+	for i := range teams {
+		teams[i].locked = make(map[uuid.UUID]bool)
+	}
+
+	conflicts := func(team *teamDraft, members []TeamPlayer, skip uuid.UUID) bool {
+		for _, m := range members {
+			for _, other := range team.members {
+				if other.UserID != skip && apart[m.UserID][other.UserID] {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	for _, g := range groups {
+		best := -1
+		for i := range teams {
+			t := &teams[i]
+			if len(t.members)+len(g.members) > maxSize || conflicts(t, g.members, uuid.Nil) {
+				continue
+			}
+			if best == -1 ||
+				len(t.members) < len(teams[best].members) ||
+				(len(t.members) == len(teams[best].members) && t.score < teams[best].score) {
+				best = i
+			}
+		}
+		if best == -1 {
+			return nil, errors.New("cannot satisfy the team constraints with this many teams")
+		}
+
+		teams[best].members = append(teams[best].members, g.members...)
+		teams[best].score += g.score
+		if len(g.members) > 1 {
+			for _, m := range g.members {
+				teams[best].locked[m.UserID] = true
+			}
+		}
+	}
+
+	// Swap free players between teams while it narrows the score gap
+	for iteration := 0; iteration < 100; iteration++ {
+		improved := false
+		for i := range teams {
+			for j := i + 1; j < len(teams); j++ {
+				if swapImproves(&teams[i], &teams[j], conflicts) {
+					improved = true
+				}
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+
+	result := make([][]TeamPlayer, teamCount)
+	for i := range teams {
+		result[i] = teams[i].members
+	}
+	return result, nil
+}
+
+// swapImproves performs the single swap between a and b that most reduces their score difference
+func swapImproves(a, b *teamDraft, conflicts func(*teamDraft, []TeamPlayer, uuid.UUID) bool) bool {
+	const epsilon = 1e-9
+	diff := a.score - b.score
+	bestGain := epsilon
+	bestX, bestY := -1, -1
+
+	for x, px := range a.members {
+		if a.locked[px.UserID] {
+			continue
+		}
+		for y, py := range b.members {
+			if b.locked[py.UserID] {
+				continue
+			}
+			newDiff := diff - 2*(px.Score-py.Score)
+			gain := math.Abs(diff) - math.Abs(newDiff)
+			if gain <= bestGain {
+				continue
+			}
+			if conflicts(a, []TeamPlayer{py}, px.UserID) || conflicts(b, []TeamPlayer{px}, py.UserID) {
+				continue
+			}
+			bestGain = gain
+			bestX, bestY = x, y
+		}
+	}
+
+	if bestX == -1 {
+		return false
+	}
+
+	px, py := a.members[bestX], b.members[bestY]
+	a.members[bestX], b.members[bestY] = py, px
+	a.score += py.Score - px.Score
+	b.score += px.Score - py.Score
+	return true
+}
@@ -0,0 +1,130 @@
+package services_test
+
+import (
+	"math"
+	"testing"
+
+	"playspotter/internal/services"
+
+	"github.com/google/uuid"
+)
+
+func makePlayers(scores ...float64) []services.TeamPlayer {
+	players := make([]services.TeamPlayer, len(scores))
+	for i, score := range scores {
+		players[i] = services.TeamPlayer{UserID: uuid.New(), Score: score}
+	}
+	return players
+}
+
+func teamOf(teams [][]services.TeamPlayer, id uuid.UUID) int {
+	for i, team := range teams {
+		for _, p := range team {
+			if p.UserID == id {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// Test team sizes and score balance
+func TestBalanceTeams(t *testing.T) {
+	players := makePlayers(5, 5, 4, 4, 3, 3, 2, 2, 1, 1)
+
+	teams, err := services.BalanceTeams(players, 2, services.TeamConstraints{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(teams) != 2 {
+		t.Fatalf("Expected 2 teams, got %d", len(teams))
+	}
+
+	var totals [2]float64
+	for i, team := range teams {
+		if len(team) != 5 {
+			t.Errorf("Expected 5 players in team %d, got %d", i, len(team))
+		}
+		for _, p := range team {
+			totals[i] += p.Score
+		}
+	}
+
+	if math.Abs(totals[0]-totals[1]) > 1 {
+		t.Errorf("Expected balanced teams, got totals %v", totals)
+	}
+}
+
+// Test locking players together and apart
+func TestBalanceTeamsConstraints(t *testing.T) {
+	players := makePlayers(5, 5, 1, 1, 3, 3)
+
+	t.Run("Together", func(t *testing.T) {
+		constraints := services.TeamConstraints{
+			Together: [][]uuid.UUID{{players[0].UserID, players[1].UserID}},
+		}
+		teams, err := services.BalanceTeams(players, 2, constraints)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if teamOf(teams, players[0].UserID) != teamOf(teams, players[1].UserID) {
+			t.Error("Expected locked players on the same team")
+		}
+	})
+
+	t.Run("Apart", func(t *testing.T) {
+		constraints := services.TeamConstraints{
+			Apart: [][]uuid.UUID{{players[2].UserID, players[3].UserID}},
+		}
+		teams, err := services.BalanceTeams(players, 2, constraints)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if teamOf(teams, players[2].UserID) == teamOf(teams, players[3].UserID) {
+			t.Error("Expected separated players on different teams")
+		}
+	})
+
+	t.Run("Contradiction", func(t *testing.T) {
+		pair := []uuid.UUID{players[0].UserID, players[1].UserID}
+		constraints := services.TeamConstraints{
+			Together: [][]uuid.UUID{pair},
+			Apart:    [][]uuid.UUID{pair},
+		}
+		if _, err := services.BalanceTeams(players, 2, constraints); err == nil {
+			t.Error("Expected error for contradictory constraints")
+		}
+	})
+
+	t.Run("Too Few Players", func(t *testing.T) {
+		if _, err := services.BalanceTeams(players[:1], 2, services.TeamConstraints{}); err == nil {
+			t.Error("Expected error when players are fewer than teams")
+		}
+	})
+}
+
+func TestDefaultTeamCount(t *testing.T) {
+	size := func(n int) *int { return &n }
+
+	tests := []struct {
+		name     string
+		players  int
+		teamSize *int
+		want     int
+	}{
+		{"No Team Size", 30, nil, 2},
+		{"Even Split", 22, size(11), 2},
+		{"Few Players", 3, size(5), 2},
+		{"Several Teams", 20, size(5), 4},
+		{"Capped At Team Colors", 60, size(5), 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := services.DefaultTeamCount(tt.players, tt.teamSize); got != tt.want {
+				t.Errorf("DefaultTeamCount(%d) = %d, want %d", tt.players, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// teamColors are handed out in order so bibs are predictable across regenerations
+var teamColors = []string{"red", "blue", "green", "yellow", "orange", "purple", "black", "white"}
+
+const defaultSkillLevel = 3
+
+type TeamService struct {
+	teamRepo        *repositories.TeamRepository
+	skillRepo       *repositories.SkillRepository
+	participantRepo *repositories.ParticipantRepository
+	eventService    *EventService
+}
+
+func NewTeamService(
+	teamRepo *repositories.TeamRepository,
+	skillRepo *repositories.SkillRepository,
+	participantRepo *repositories.ParticipantRepository,
+	eventService *EventService,
+) *TeamService {
+	return &TeamService{
+		teamRepo:        teamRepo,
+		skillRepo:       skillRepo,
+		participantRepo: participantRepo,
+		eventService:    eventService,
+	}
+}
+
+// GenerateTeamsInput configures a team split; Ratings are organizer overrides on the 1-5 skill scale
+type GenerateTeamsInput struct {
+	TeamCount int
+	Together  [][]uuid.UUID
+	Apart     [][]uuid.UUID
+	Ratings   map[uuid.UUID]float64
+}
+
+// ListTeams returns the teams of an event the caller can view
+func (s *TeamService) ListTeams(eventID uuid.UUID, userID *uuid.UUID, isAdmin bool) ([]models.EventTeam, error) {
+	if _, err := s.eventService.ViewEvent(eventID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.teamRepo.ListByEvent(eventID)
+}
+
+// GenerateTeams splits the event's active participants into balanced teams and
+// replaces any earlier split
+func (s *TeamService) GenerateTeams(eventID, userID uuid.UUID, isAdmin bool, input GenerateTeamsInput) ([]models.EventTeam, error) {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventService.authorize(event, userID, isAdmin, PermManageParticipants); err != nil {
+		return nil, err
+	}
+
+	if event.Status == models.EventStatusCancelled {
+		return nil, errors.New("cannot generate teams for a cancelled event")
+	}
+
+	participants, err := s.participantRepo.ListByEvent(eventID, models.ParticipantStatusJoined)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, len(participants))
+	for i, p := range participants {
+		userIDs[i] = p.UserID
+	}
+
+	levels, err := s.skillRepo.LevelsForUsers(event.SportType, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	players := make([]TeamPlayer, len(userIDs))
+	for i, id := range userIDs {
+		score := float64(defaultSkillLevel)
+		if level, ok := levels[id]; ok {
+			score = float64(level)
+		}
+		if rating, ok := input.Ratings[id]; ok {
+			if rating < 1 || rating > 5 {
+				return nil, errors.New("ratings must be between 1 and 5")
+			}
+			score = rating
+		}
+		players[i] = TeamPlayer{UserID: id, Score: score}
+	}
+
+	teamCount := input.TeamCount
+	if teamCount == 0 {
+		teamCount = s.defaultTeamCount(event.SportType, len(players))
+	}
+	if teamCount > len(teamColors) {
+		return nil, errors.New("at most 8 teams are supported")
+	}
+
+	split, err := BalanceTeams(players, teamCount, TeamConstraints{
+		Together: input.Together,
+		Apart:    input.Apart,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]models.EventTeam, len(split))
+	for i, members := range split {
+		color := teamColors[i]
+		teams[i] = models.EventTeam{
+			EventID:  eventID,
+			Name:     "Team " + strings.ToUpper(color[:1]) + color[1:],
+			Color:    color,
+			Position: i + 1,
+		}
+		for _, m := range members {
+			teams[i].Members = append(teams[i].Members, models.EventTeamMember{
+				EventID: eventID,
+				UserID:  m.UserID,
+			})
+		}
+	}
+
+	if err := s.teamRepo.ReplaceForEvent(eventID, teams); err != nil {
+		return nil, err
+	}
+
+	return s.teamRepo.ListByEvent(eventID)
+}
+
+func (s *TeamService) DeleteTeams(eventID, userID uuid.UUID, isAdmin bool) error {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.eventService.authorize(event, userID, isAdmin, PermManageParticipants); err != nil {
+		return err
+	}
+
+	return s.teamRepo.DeleteByEvent(eventID)
+}
+
+// defaultTeamCount derives the number of teams from the sport's usual team size
+func (s *TeamService) defaultTeamCount(sportType string, players int) int {
+	sport, err := s.eventService.sportService.Resolve(sportType)
+	if err != nil {
+		return 2
+	}
+	return DefaultTeamCount(players, sport.DefaultTeamSize)
+}
+
+// DefaultTeamCount splits the players into teams of the given size, with at least
+// two teams and no more than there are team colors. A missing size means two teams.
+func DefaultTeamCount(players int, teamSize *int) int {
+	if teamSize == nil || *teamSize < 1 {
+		return 2
+	}
+
+	count := players / *teamSize
+	if count < 2 {
+		return 2
+	}
+	if count > len(teamColors) {
+		return len(teamColors)
+	}
+	return count
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrTemplateNotFound is returned when a template does not exist or belongs to someone else
+var ErrTemplateNotFound = errors.New("template not found")
+
+type TemplateService struct {
+	templateRepo *repositories.TemplateRepository
+	eventService *EventService
+}
+
+func NewTemplateService(templateRepo *repositories.TemplateRepository, eventService *EventService) *TemplateService {
+	return &TemplateService{
+		templateRepo: templateRepo,
+		eventService: eventService,
+	}
+}
+
+func (s *TemplateService) ListTemplates(userID uuid.UUID) ([]models.EventTemplate, error) {
+	return s.templateRepo.ListByUser(userID)
+}
+
+func (s *TemplateService) GetTemplate(id, userID uuid.UUID) (*models.EventTemplate, error) {
+	template, err := s.templateRepo.FindByID(id)
+	if err != nil || template.UserID != userID {
+		return nil, ErrTemplateNotFound
+	}
+	return template, nil
+}
+
+// CreateTemplate validates the details the same way events are validated
+func (s *TemplateService) CreateTemplate(template *models.EventTemplate) error {
+	if err := s.prepare(template); err != nil {
+		return err
+	}
+	return s.templateRepo.Create(template)
+}
+
+// UpdateTemplate replaces the template's details
+func (s *TemplateService) UpdateTemplate(id, userID uuid.UUID, details *models.EventTemplate) (*models.EventTemplate, error) {
+	template, err := s.GetTemplate(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	details.ID = template.ID
+	details.UserID = template.UserID
+	details.CreatedAt = template.CreatedAt
+	if err := s.prepare(details); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Update(details); err != nil {
+		return nil, err
+	}
+	return s.templateRepo.FindByID(template.ID)
+}
+
+func (s *TemplateService) DeleteTemplate(id, userID uuid.UUID) error {
+	template, err := s.GetTemplate(id, userID)
+	if err != nil {
+		return err
+	}
+	return s.templateRepo.Delete(template.ID)
+}
+
+// CreateEvent creates an event owned by the user from one of their templates
+func (s *TemplateService) CreateEvent(id, userID uuid.UUID, opts EventCopyOptions) (*models.Event, error) {
+	template, err := s.GetTemplate(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := templateEvent(template)
+	event.CreatorID = userID
+	opts.apply(event)
+
+	if err := s.eventService.CreateEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// prepare runs the template through the event validation and keeps what it
+// normalized, such as the sport slug and the venue's location
+func (s *TemplateService) prepare(template *models.EventTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return errors.New("template name is required")
+	}
+
+	event := templateEvent(template)
+	if err := s.eventService.prepareEvent(event); err != nil {
+		return err
+	}
+
+	template.SportType = event.SportType
+	template.VenueID = event.VenueID
+	template.LocationName = event.LocationName
+	template.Address = event.Address
+	template.Latitude = event.Latitude
+	template.Longitude = event.Longitude
+	template.PriceCents = event.PriceCents
+	template.Currency = event.Currency
+	template.DurationMin = event.DurationMin
+	template.TimeZone = event.TimeZone
+	template.Visibility = event.Visibility
+	return nil
+}
+
+func templateEvent(template *models.EventTemplate) *models.Event {
+	return &models.Event{
+		Title:        template.Title,
+		SportType:    template.SportType,
+		DurationMin:  template.DurationMin,
+		TimeZone:     template.TimeZone,
+		VenueID:      template.VenueID,
+		LocationName: template.LocationName,
+		Address:      template.Address,
+		Latitude:     template.Latitude,
+		Longitude:    template.Longitude,
+		Capacity:     template.Capacity,
+		Description:  template.Description,
+		PriceCents:   template.PriceCents,
+		Currency:     template.Currency,
+		Visibility:   template.Visibility,
+	}
+}
@@ -0,0 +1,583 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrTournamentNotFound is returned when a tournament, team or match does not exist
+var ErrTournamentNotFound = errors.New("tournament not found")
+
+const (
+	defaultMatchMinutes = 30
+	defaultCourts       = 1
+)
+
+type TournamentService struct {
+	tournamentRepo  *repositories.TournamentRepository
+	participantRepo *repositories.ParticipantRepository
+	eventService    *EventService
+}
+
+func NewTournamentService(
+	tournamentRepo *repositories.TournamentRepository,
+	participantRepo *repositories.ParticipantRepository,
+	eventService *EventService,
+) *TournamentService {
+	return &TournamentService{
+		tournamentRepo:  tournamentRepo,
+		participantRepo: participantRepo,
+		eventService:    eventService,
+	}
+}
+
+// CreateTournamentInput configures a tournament; nil point values keep the 3/1/0 defaults
+type CreateTournamentInput struct {
+	Format     string
+	MaxTeams   int
+	PointsWin  *int
+	PointsDraw *int
+	PointsLoss *int
+}
+
+// GenerateBracketInput controls match scheduling; zero values fall back to the event
+// start time, 30 minute matches and a single court
+type GenerateBracketInput struct {
+	StartAt      *time.Time
+	MatchMinutes int
+	Courts       int
+}
+
+// RecordResultInput is a final score; WinnerTeamID settles knockout draws (e.g. on penalties)
+type RecordResultInput struct {
+	HomeScore    int
+	AwayScore    int
+	WinnerTeamID *uuid.UUID
+}
+
+func (s *TournamentService) CreateTournament(eventID, userID uuid.UUID, isAdmin bool, input CreateTournamentInput) (*models.Tournament, error) {
+	event, err := s.eventService.GetEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventService.authorize(event, userID, isAdmin, PermEditEvent); err != nil {
+		return nil, err
+	}
+
+	if event.Status == models.EventStatusCancelled {
+		return nil, errors.New("cannot create a tournament for a cancelled event")
+	}
+
+	if input.Format != models.TournamentFormatSingleElimination && input.Format != models.TournamentFormatRoundRobin {
+		return nil, errors.New("format must be single_elimination or round_robin")
+	}
+
+	if input.MaxTeams < 2 {
+		return nil, errors.New("max_teams must be at least 2")
+	}
+
+	if existing, err := s.tournamentRepo.FindByEventID(eventID); err == nil && existing != nil {
+		return nil, errors.New("event already has a tournament")
+	}
+
+	tournament := &models.Tournament{
+		EventID:    eventID,
+		Format:     input.Format,
+		Status:     models.TournamentStatusRegistration,
+		MaxTeams:   input.MaxTeams,
+		PointsWin:  3,
+		PointsDraw: 1,
+		PointsLoss: 0,
+	}
+	if input.PointsWin != nil {
+		tournament.PointsWin = *input.PointsWin
+	}
+	if input.PointsDraw != nil {
+		tournament.PointsDraw = *input.PointsDraw
+	}
+	if input.PointsLoss != nil {
+		tournament.PointsLoss = *input.PointsLoss
+	}
+
+	if err := s.tournamentRepo.Create(tournament); err != nil {
+		return nil, err
+	}
+	return tournament, nil
+}
+
+func (s *TournamentService) GetTournament(id uuid.UUID) (*models.Tournament, error) {
+	tournament, err := s.tournamentRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrTournamentNotFound
+	}
+	return tournament, nil
+}
+
+// GetEventTournament returns the tournament of an event the caller can view
+func (s *TournamentService) GetEventTournament(eventID uuid.UUID, userID *uuid.UUID, isAdmin bool) (*models.Tournament, error) {
+	if _, err := s.eventService.ViewEvent(eventID, userID, isAdmin); err != nil {
+		return nil, ErrTournamentNotFound
+	}
+
+	tournament, err := s.tournamentRepo.FindByEventID(eventID)
+	if err != nil {
+		return nil, ErrTournamentNotFound
+	}
+	return tournament, nil
+}
+
+// viewTournament returns the tournament if the caller can view its event; tournaments
+// of drafts and hidden events are reported as not found
+func (s *TournamentService) viewTournament(id uuid.UUID, userID *uuid.UUID, isAdmin bool) (*models.Tournament, error) {
+	tournament, err := s.GetTournament(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.eventService.ViewEvent(tournament.EventID, userID, isAdmin); err != nil {
+		return nil, ErrTournamentNotFound
+	}
+	return tournament, nil
+}
+
+func (s *TournamentService) ListTeams(tournamentID uuid.UUID, userID *uuid.UUID, isAdmin bool) ([]models.TournamentTeam, error) {
+	if _, err := s.viewTournament(tournamentID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.tournamentRepo.ListTeams(tournamentID)
+}
+
+// RegisterTeam enters a team captained by the caller. Every member must have joined
+// the event and may play for only one team in the tournament.
+func (s *TournamentService) RegisterTeam(tournamentID, captainID uuid.UUID, name string, memberIDs []uuid.UUID) (*models.TournamentTeam, error) {
+	tournament, err := s.GetTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tournament.Status != models.TournamentStatusRegistration {
+		return nil, errors.New("tournament registration is closed")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("team name is required")
+	}
+
+	teams, err := s.tournamentRepo.ListTeams(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(teams) >= tournament.MaxTeams {
+		return nil, errors.New("tournament is full")
+	}
+
+	// Seeds follow registration order and stay unique after withdrawals
+	seed := 1
+	if len(teams) > 0 {
+		seed = teams[len(teams)-1].Seed + 1
+	}
+
+	// The captain always plays for their own team
+	seen := map[uuid.UUID]bool{captainID: true}
+	members := []uuid.UUID{captainID}
+	for _, id := range memberIDs {
+		if !seen[id] {
+			seen[id] = true
+			members = append(members, id)
+		}
+	}
+
+	for _, id := range members {
+		joined, err := s.participantRepo.Exists(tournament.EventID, id)
+		if err != nil {
+			return nil, err
+		}
+		if !joined {
+			return nil, errors.New("all team members must have joined the event")
+		}
+	}
+
+	taken, err := s.tournamentRepo.MembersOnOtherTeams(tournamentID, members)
+	if err != nil {
+		return nil, err
+	}
+	if len(taken) > 0 {
+		return nil, errors.New("a team member is already registered with another team")
+	}
+
+	team := &models.TournamentTeam{
+		TournamentID: tournamentID,
+		Name:         name,
+		CaptainID:    captainID,
+		Seed:         seed,
+	}
+	for _, id := range members {
+		team.Members = append(team.Members, models.TournamentTeamMember{
+			TournamentID: tournamentID,
+			UserID:       id,
+		})
+	}
+
+	if err := s.tournamentRepo.CreateTeam(team); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// WithdrawTeam removes a team before the bracket is drawn (captain or organizers)
+func (s *TournamentService) WithdrawTeam(tournamentID, teamID, userID uuid.UUID, isAdmin bool) error {
+	tournament, err := s.GetTournament(tournamentID)
+	if err != nil {
+		return err
+	}
+
+	team, err := s.tournamentRepo.FindTeam(tournamentID, teamID)
+	if err != nil {
+		return ErrTournamentNotFound
+	}
+
+	if team.CaptainID != userID {
+		if err := s.authorizeTournament(tournament, userID, isAdmin); err != nil {
+			return err
+		}
+	}
+
+	if tournament.Status != models.TournamentStatusRegistration {
+		return errors.New("teams cannot withdraw once the bracket is drawn")
+	}
+
+	return s.tournamentRepo.DeleteTeam(teamID)
+}
+
+// GenerateBracket closes registration, draws the fixtures for the tournament format
+// and schedules them back to back across the available courts
+func (s *TournamentService) GenerateBracket(tournamentID, userID uuid.UUID, isAdmin bool, input GenerateBracketInput) ([]models.TournamentMatch, error) {
+	tournament, err := s.GetTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventService.GetEvent(tournament.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventService.authorize(event, userID, isAdmin, PermEditEvent); err != nil {
+		return nil, err
+	}
+
+	if tournament.Status != models.TournamentStatusRegistration {
+		return nil, errors.New("bracket has already been generated")
+	}
+
+	teams, err := s.tournamentRepo.ListTeams(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamIDs := make([]uuid.UUID, len(teams))
+	for i, team := range teams {
+		teamIDs[i] = team.ID
+	}
+
+	var fixtures []BracketMatch
+	if tournament.Format == models.TournamentFormatSingleElimination {
+		fixtures, err = SingleEliminationBracket(teamIDs)
+	} else {
+		fixtures, err = RoundRobinSchedule(teamIDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]models.TournamentMatch, len(fixtures))
+	for i, f := range fixtures {
+		matches[i] = models.TournamentMatch{
+			ID:           uuid.New(),
+			TournamentID: tournamentID,
+			Round:        f.Round,
+			Position:     f.Position,
+			HomeTeamID:   f.Home,
+			AwayTeamID:   f.Away,
+			Status:       models.MatchStatusScheduled,
+		}
+	}
+	for i, f := range fixtures {
+		if f.Next >= 0 {
+			slot := f.NextSlot
+			matches[i].NextMatchID = &matches[f.Next].ID
+			matches[i].NextSlot = &slot
+		}
+	}
+
+	// Teams with a bye go straight through to the next round
+	for i, f := range fixtures {
+		if f.Round != 1 || !f.IsBye() {
+			continue
+		}
+		winner := f.Home
+		if winner == nil {
+			winner = f.Away
+		}
+		matches[i].Status = models.MatchStatusBye
+		matches[i].WinnerTeamID = winner
+		if f.Next >= 0 {
+			advanceInto(&matches[f.Next], f.NextSlot, *winner)
+		}
+	}
+
+	startAt := event.EventTime
+	if input.StartAt != nil {
+		startAt = *input.StartAt
+	}
+	matchMinutes := input.MatchMinutes
+	if matchMinutes <= 0 {
+		matchMinutes = defaultMatchMinutes
+	}
+	courts := input.Courts
+	if courts <= 0 {
+		courts = defaultCourts
+	}
+	scheduleMatches(matches, startAt, time.Duration(matchMinutes)*time.Minute, courts)
+
+	tournament.Status = models.TournamentStatusInProgress
+	if err := s.tournamentRepo.StartWithMatches(tournament, matches); err != nil {
+		return nil, err
+	}
+
+	return s.tournamentRepo.ListMatches(tournamentID)
+}
+
+func (s *TournamentService) ListMatches(tournamentID uuid.UUID, userID *uuid.UUID, isAdmin bool) ([]models.TournamentMatch, error) {
+	if _, err := s.viewTournament(tournamentID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.tournamentRepo.ListMatches(tournamentID)
+}
+
+// ScheduleMatch moves a single match to a new kick-off time
+func (s *TournamentService) ScheduleMatch(tournamentID, matchID, userID uuid.UUID, isAdmin bool, at time.Time) (*models.TournamentMatch, error) {
+	tournament, err := s.GetTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeTournament(tournament, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	match, err := s.tournamentRepo.FindMatch(tournamentID, matchID)
+	if err != nil {
+		return nil, ErrTournamentNotFound
+	}
+
+	if match.Status != models.MatchStatusScheduled {
+		return nil, errors.New("only scheduled matches can be rescheduled")
+	}
+
+	match.ScheduledAt = &at
+	if err := s.tournamentRepo.UpdateMatch(match); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// RecordResult stores a match score, advances the knockout winner and finishes the
+// tournament after the final (or the last league match)
+func (s *TournamentService) RecordResult(tournamentID, matchID, userID uuid.UUID, isAdmin bool, input RecordResultInput) (*models.TournamentMatch, error) {
+	tournament, err := s.GetTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeTournament(tournament, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	if tournament.Status == models.TournamentStatusRegistration {
+		return nil, errors.New("bracket has not been generated yet")
+	}
+
+	match, err := s.tournamentRepo.FindMatch(tournamentID, matchID)
+	if err != nil {
+		return nil, ErrTournamentNotFound
+	}
+
+	if match.Status == models.MatchStatusBye {
+		return nil, errors.New("cannot record a result for a bye")
+	}
+	if match.HomeTeamID == nil || match.AwayTeamID == nil {
+		return nil, errors.New("both teams must be known before recording a result")
+	}
+	if input.HomeScore < 0 || input.AwayScore < 0 {
+		return nil, errors.New("scores cannot be negative")
+	}
+
+	var winner *uuid.UUID
+	switch {
+	case input.HomeScore > input.AwayScore:
+		winner = match.HomeTeamID
+	case input.AwayScore > input.HomeScore:
+		winner = match.AwayTeamID
+	case tournament.Format == models.TournamentFormatSingleElimination:
+		if input.WinnerTeamID == nil {
+			return nil, errors.New("winner_team_id is required for a drawn knockout match")
+		}
+		if *input.WinnerTeamID != *match.HomeTeamID && *input.WinnerTeamID != *match.AwayTeamID {
+			return nil, errors.New("winner must be one of the teams in the match")
+		}
+		winner = input.WinnerTeamID
+	}
+
+	var next *models.TournamentMatch
+	if match.NextMatchID != nil {
+		next, err = s.tournamentRepo.FindMatchByID(*match.NextMatchID)
+		if err != nil {
+			return nil, err
+		}
+		if next.Status == models.MatchStatusCompleted {
+			return nil, errors.New("cannot change a result after the next round has been played")
+		}
+		advanceInto(next, *match.NextSlot, *winner)
+	}
+
+	homeScore, awayScore := input.HomeScore, input.AwayScore
+	match.HomeScore = &homeScore
+	match.AwayScore = &awayScore
+	match.WinnerTeamID = winner
+	match.Status = models.MatchStatusCompleted
+
+	var finished *models.Tournament
+	if tournament.Format == models.TournamentFormatSingleElimination {
+		if match.NextMatchID == nil {
+			tournament.Status = models.TournamentStatusCompleted
+			tournament.WinnerTeamID = winner
+			finished = tournament
+		}
+	} else {
+		finished, err = s.leagueOutcome(tournament, match)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.tournamentRepo.SaveResult(match, next, finished); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// Standings builds the league table from completed matches
+func (s *TournamentService) Standings(tournamentID uuid.UUID, userID *uuid.UUID, isAdmin bool) ([]Standing, error) {
+	tournament, err := s.viewTournament(tournamentID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	return s.standings(tournament, nil)
+}
+
+func (s *TournamentService) standings(tournament *models.Tournament, pending *models.TournamentMatch) ([]Standing, error) {
+	teams, err := s.tournamentRepo.ListTeams(tournament.ID)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.tournamentRepo.ListMatches(tournament.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uuid.UUID]string, len(teams))
+	for _, team := range teams {
+		names[team.ID] = team.Name
+	}
+
+	var results []MatchResult
+	for _, m := range matches {
+		if pending != nil && m.ID == pending.ID {
+			m = *pending
+		}
+		if m.Status != models.MatchStatusCompleted || m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+		results = append(results, MatchResult{
+			Home:      *m.HomeTeamID,
+			Away:      *m.AwayTeamID,
+			HomeScore: *m.HomeScore,
+			AwayScore: *m.AwayScore,
+		})
+	}
+
+	return ComputeStandings(names, results, tournament.PointsWin, tournament.PointsDraw, tournament.PointsLoss), nil
+}
+
+// leagueOutcome returns the tournament to save when the pending result completes a
+// round robin, with the table leader as winner; nil while matches remain
+func (s *TournamentService) leagueOutcome(tournament *models.Tournament, pending *models.TournamentMatch) (*models.Tournament, error) {
+	matches, err := s.tournamentRepo.ListMatches(tournament.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		if m.ID != pending.ID && m.Status == models.MatchStatusScheduled {
+			return nil, nil
+		}
+	}
+
+	table, err := s.standings(tournament, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	tournament.Status = models.TournamentStatusCompleted
+	tournament.WinnerTeamID = nil
+	if len(table) > 0 {
+		tournament.WinnerTeamID = &table[0].TeamID
+	}
+	return tournament, nil
+}
+
+func (s *TournamentService) authorizeTournament(tournament *models.Tournament, userID uuid.UUID, isAdmin bool) error {
+	event, err := s.eventService.GetEvent(tournament.EventID)
+	if err != nil {
+		return err
+	}
+	return s.eventService.authorize(event, userID, isAdmin, PermEditEvent)
+}
+
+// advanceInto places a winning team in the home or away slot of the next match
+func advanceInto(next *models.TournamentMatch, slot string, teamID uuid.UUID) {
+	id := teamID
+	if slot == "away" {
+		next.AwayTeamID = &id
+		return
+	}
+	next.HomeTeamID = &id
+}
+
+// scheduleMatches assigns kick-off times round by round, playing up to courts
+// matches at once; byes take no court time
+func scheduleMatches(matches []models.TournamentMatch, startAt time.Time, length time.Duration, courts int) {
+	slot := startAt
+	onCourt := 0
+	round := 0
+	for i := range matches {
+		m := &matches[i]
+		if m.Status == models.MatchStatusBye {
+			continue
+		}
+		if (round != 0 && m.Round != round) || onCourt == courts {
+			slot = slot.Add(length)
+			onCourt = 0
+		}
+		round = m.Round
+		at := slot
+		m.ScheduledAt = &at
+		onCourt++
+	}
+}
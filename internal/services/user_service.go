@@ -4,18 +4,25 @@ import (
 	"errors"
 	"playspotter/internal/models"
 	"playspotter/internal/repositories"
+	"strings"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type UserService struct {
-	userRepo *repositories.UserRepository
+	userRepo     *repositories.UserRepository
+	skillRepo    *repositories.SkillRepository
+	sportService *SportService
+	auditService *AuditService
 }
 
-func NewUserService(userRepo *repositories.UserRepository) *UserService {
+func NewUserService(userRepo *repositories.UserRepository, skillRepo *repositories.SkillRepository, sportService *SportService, auditService *AuditService) *UserService {
 	return &UserService{
-		userRepo: userRepo,
+		userRepo:     userRepo,
+		skillRepo:    skillRepo,
+		sportService: sportService,
+		auditService: auditService,
 	}
 }
 
@@ -23,32 +30,96 @@ func (s *UserService) GetUser(id uuid.UUID) (*models.User, error) {
 	return s.userRepo.FindByID(id)
 }
 
-func (s *UserService) UpdateUser(id uuid.UUID, name, password string) error {
+// UserUpdate holds the changes a user makes to their account and profile; empty
+// strings and nil values leave a field unchanged
+type UserUpdate struct {
+	Name              string
+	Password          string
+	AvatarURL         *string
+	Bio               *string
+	FavoriteSports    []string
+	HomeArea          *string
+	ProfileVisibility string
+	ShowHomeArea      *bool
+	ShowStats         *bool
+}
+
+func (s *UserService) UpdateUser(id uuid.UUID, update UserUpdate) error {
 	user, err := s.userRepo.FindByID(id)
 	if err != nil {
 		return err
 	}
 
-	if name != "" {
-		user.Name = name
+	if update.Name != "" {
+		user.Name = update.Name
 	}
 
-	if password != "" {
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if update.Password != "" {
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(update.Password), bcrypt.DefaultCost)
 		if err != nil {
 			return err
 		}
 		user.PasswordHash = string(hashedPassword)
 	}
 
+	// Sending an empty string clears a profile field
+	if update.AvatarURL != nil {
+		user.AvatarURL = optionalText(*update.AvatarURL)
+	}
+	if update.Bio != nil {
+		user.Bio = optionalText(*update.Bio)
+	}
+	if update.HomeArea != nil {
+		user.HomeArea = optionalText(*update.HomeArea)
+	}
+
+	if update.FavoriteSports != nil {
+		sports := make([]string, 0, len(update.FavoriteSports))
+		for _, input := range update.FavoriteSports {
+			sport, err := s.sportService.Resolve(input)
+			if err != nil {
+				return errors.New("unknown sport type: " + input)
+			}
+			sports = append(sports, sport.Slug)
+		}
+		user.FavoriteSports = mergeStrings(nil, sports)
+	}
+	if user.FavoriteSports == nil {
+		user.FavoriteSports = []string{}
+	}
+
+	switch update.ProfileVisibility {
+	case "":
+	case models.ProfileVisibilityPublic, models.ProfileVisibilityFollowers, models.ProfileVisibilityPrivate:
+		user.ProfileVisibility = update.ProfileVisibility
+	default:
+		return errors.New("profile visibility must be public, followers or private")
+	}
+	if update.ShowHomeArea != nil {
+		user.ShowHomeArea = *update.ShowHomeArea
+	}
+	if update.ShowStats != nil {
+		user.ShowStats = *update.ShowStats
+	}
+
 	return s.userRepo.Update(user)
 }
 
+// optionalText trims the value and turns an empty result into nil
+func optionalText(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 func (s *UserService) ListUsers(offset, limit int) ([]models.User, int64, error) {
 	return s.userRepo.List(offset, limit)
 }
 
-func (s *UserService) UpdateUserRole(id uuid.UUID, role string) error {
+// UpdateUserRole changes the user's role and records the change in the audit log
+func (s *UserService) UpdateUserRole(id uuid.UUID, role string, meta AuditMeta) error {
 	if role != "user" && role != "admin" {
 		return errors.New("invalid role")
 	}
@@ -58,6 +129,56 @@ func (s *UserService) UpdateUserRole(id uuid.UUID, role string) error {
 		return err
 	}
 
+	previous := user.Role
+	if previous == role {
+		return nil
+	}
 	user.Role = role
-	return s.userRepo.Update(user)
+	if err := s.userRepo.Update(user); err != nil {
+		return err
+	}
+
+	s.auditService.Record(meta, AuditEntry{
+		Action:     models.AuditActionRoleChanged,
+		TargetType: models.AuditTargetUser,
+		TargetID:   &user.ID,
+		Before:     map[string]interface{}{"role": previous},
+		After:      map[string]interface{}{"role": role},
+	})
+	return nil
+}
+
+func (s *UserService) ListSportSkills(userID uuid.UUID) ([]models.UserSportSkill, error) {
+	return s.skillRepo.ListByUser(userID)
+}
+
+// SetSportSkill records the user's self-assessed level (1-5) for a sport
+func (s *UserService) SetSportSkill(userID uuid.UUID, sportType string, level int) (*models.UserSportSkill, error) {
+	if level < 1 || level > 5 {
+		return nil, errors.New("level must be between 1 and 5")
+	}
+
+	sport, err := s.sportService.Resolve(sportType)
+	if err != nil {
+		return nil, err
+	}
+
+	skill := &models.UserSportSkill{
+		UserID:    userID,
+		SportType: sport.Slug,
+		Level:     level,
+	}
+	if err := s.skillRepo.Upsert(skill); err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+func (s *UserService) DeleteSportSkill(userID uuid.UUID, sportType string) error {
+	sport, err := s.sportService.Resolve(sportType)
+	if err != nil {
+		return err
+	}
+	return s.skillRepo.Delete(userID, sport.Slug)
 }
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"errors"
+	"playspotter/internal/models"
+	"playspotter/internal/repositories"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	openingHoursPattern = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d-([01]\d|2[0-4]):[0-5]\d$`)
+	openingDays         = map[string]bool{"mon": true, "tue": true, "wed": true, "thu": true, "fri": true, "sat": true, "sun": true}
+)
+
+// ErrVenueInUse is returned when a venue creator tries to move a venue that other
+// organizers' upcoming events are held at
+var ErrVenueInUse = errors.New("other organizers have upcoming events at this venue; ask an admin to move it")
+
+type VenueService struct {
+	venueRepo    *repositories.VenueRepository
+	sportService *SportService
+}
+
+func NewVenueService(venueRepo *repositories.VenueRepository, sportService *SportService) *VenueService {
+	return &VenueService{
+		venueRepo:    venueRepo,
+		sportService: sportService,
+	}
+}
+
+func (s *VenueService) CreateVenue(venue *models.Venue) error {
+	if err := s.validate(venue); err != nil {
+		return err
+	}
+	return s.venueRepo.Create(venue)
+}
+
+func (s *VenueService) GetVenue(id uuid.UUID) (*models.Venue, error) {
+	return s.venueRepo.FindByID(id)
+}
+
+func (s *VenueService) SearchVenues(filter repositories.VenueFilter) ([]models.VenueWithDistance, int64, error) {
+	if filter.SportType != "" {
+		if sport, err := s.sportService.Resolve(filter.SportType); err == nil {
+			filter.SportType = sport.Slug
+		}
+	}
+	return s.venueRepo.Search(filter)
+}
+
+func (s *VenueService) UpdateVenue(id uuid.UUID, updates *models.Venue, userID uuid.UUID, isAdmin bool) (*models.Venue, error) {
+	venue, err := s.venueRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check permissions
+	if !isAdmin && (venue.CreatedBy == nil || *venue.CreatedBy != userID) {
+		return nil, errors.New("only venue creator or admin can update this venue")
+	}
+
+	// Moving the venue moves its upcoming events, so only admins may move events
+	// that belong to other organizers
+	moved := (updates.Latitude != 0 && updates.Latitude != venue.Latitude) ||
+		(updates.Longitude != 0 && updates.Longitude != venue.Longitude)
+	if moved && !isAdmin {
+		inUse, err := s.venueRepo.HasUpcomingEventsByOthers(venue.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if inUse {
+			return nil, ErrVenueInUse
+		}
+	}
+
+	if updates.Name != "" {
+		venue.Name = updates.Name
+	}
+	if updates.Address != nil {
+		venue.Address = updates.Address
+	}
+	if updates.Latitude != 0 {
+		venue.Latitude = updates.Latitude
+	}
+	if updates.Longitude != 0 {
+		venue.Longitude = updates.Longitude
+	}
+	if updates.Sports != nil {
+		venue.Sports = updates.Sports
+	}
+	if updates.Amenities != nil {
+		venue.Amenities = updates.Amenities
+	}
+	if updates.OpeningHours != nil {
+		venue.OpeningHours = updates.OpeningHours
+	}
+
+	if err := s.validate(venue); err != nil {
+		return nil, err
+	}
+
+	if err := s.venueRepo.Update(venue); err != nil {
+		return nil, err
+	}
+
+	if err := s.venueRepo.SyncUpcomingEvents(venue); err != nil {
+		return nil, err
+	}
+
+	return venue, nil
+}
+
+// MergeVenues folds a duplicate venue into the target: its events are moved over,
+// sports and amenities are combined and missing details are filled from the duplicate
+func (s *VenueService) MergeVenues(targetID, duplicateID uuid.UUID) (*models.Venue, error) {
+	if targetID == duplicateID {
+		return nil, errors.New("cannot merge a venue into itself")
+	}
+
+	target, err := s.venueRepo.FindByID(targetID)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := s.venueRepo.FindByID(duplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.Address == nil {
+		target.Address = duplicate.Address
+	}
+	target.Sports = mergeStrings(target.Sports, duplicate.Sports)
+	target.Amenities = mergeStrings(target.Amenities, duplicate.Amenities)
+	if target.OpeningHours == nil {
+		target.OpeningHours = map[string]string{}
+	}
+	for day, hours := range duplicate.OpeningHours {
+		if _, ok := target.OpeningHours[day]; !ok {
+			target.OpeningHours[day] = hours
+		}
+	}
+
+	if err := s.venueRepo.Merge(target, duplicate.ID); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+func (s *VenueService) validate(venue *models.Venue) error {
+	venue.Name = strings.TrimSpace(venue.Name)
+	if venue.Name == "" {
+		return errors.New("venue name is required")
+	}
+
+	// Validate coordinates
+	if venue.Latitude < -90 || venue.Latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if venue.Longitude < -180 || venue.Longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+
+	// Store catalog slugs for supported sports
+	sports := make([]string, 0, len(venue.Sports))
+	for _, input := range venue.Sports {
+		sport, err := s.sportService.Resolve(input)
+		if err != nil {
+			return errors.New("unknown sport type: " + input)
+		}
+		sports = append(sports, sport.Slug)
+	}
+	venue.Sports = mergeStrings(nil, sports)
+
+	amenities := make([]string, 0, len(venue.Amenities))
+	for _, amenity := range venue.Amenities {
+		amenities = append(amenities, strings.ToLower(strings.TrimSpace(amenity)))
+	}
+	venue.Amenities = mergeStrings(nil, amenities)
+
+	if venue.OpeningHours == nil {
+		venue.OpeningHours = map[string]string{}
+	}
+	for day, hours := range venue.OpeningHours {
+		if !openingDays[day] {
+			return errors.New("opening hours day must be one of mon, tue, wed, thu, fri, sat, sun")
+		}
+		if hours != "closed" && !openingHoursPattern.MatchString(hours) {
+			return errors.New("opening hours must be formatted as HH:MM-HH:MM or closed")
+		}
+	}
+
+	return nil
+}
+
+// mergeStrings appends values from b missing in a, dropping empty strings and duplicates
+func mergeStrings(a, b []string) []string {
+	result := make([]string, 0, len(a)+len(b))
+	seen := make(map[string]bool)
+	for _, list := range [][]string{a, b} {
+		for _, value := range list {
+			if value == "" || seen[value] {
+				continue
+			}
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+// Package timezone resolves and validates IANA time zones for events.
+package timezone
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrInvalidZone is returned for names that are not IANA time zones
+var ErrInvalidZone = errors.New("time zone must be an IANA name such as Asia/Makassar")
+
+// Load returns the location for an IANA zone name. Unlike time.LoadLocation it
+// rejects "" and "Local", which would depend on the server's settings.
+func Load(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, ErrInvalidZone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidZone
+	}
+	return loc, nil
+}
+
+// region is an area where a single zone applies; contains reports whether a
+// coordinate falls inside it
+type region struct {
+	zone     string
+	contains func(lat, lng float64) bool
+}
+
+// regions are checked in order, so smaller areas come before the larger ones
+// around them. The borders are approximate; organizers near them can pick the
+// zone explicitly.
+var regions = []region{
+	{"Asia/Singapore", func(lat, lng float64) bool {
+		return lat >= 1.2 && lat <= 1.48 && lng >= 103.6 && lng <= 104.1
+	}},
+	// Peninsular Malaysia, separated from Sumatra along the Strait of Malacca
+	{"Asia/Kuala_Lumpur", func(lat, lng float64) bool {
+		return lng >= 99.6 && lng <= 104.6 && lat <= 6.8 && lat > math.Max(1.25, 3.4-0.8*(lng-100))
+	}},
+	// Sarawak, Brunei and Sabah on the north of Borneo
+	{"Asia/Kuching", func(lat, lng float64) bool {
+		if lng < 109.5 || lng > 119.3 || lat > 7.4 {
+			return false
+		}
+		border := 1.0
+		switch {
+		case lng >= 116:
+			border = 4.2
+		case lng >= 114.5:
+			border = 2.0 + (lng-114.5)*(2.2/1.5)
+		case lng >= 112:
+			border = 1.0 + (lng-112)*(1.0/2.5)
+		}
+		return lat > border
+	}},
+	{"Asia/Dili", func(lat, lng float64) bool {
+		return lat >= -9.5 && lat <= -8.1 && lng >= 125.05 && lng <= 127.4
+	}},
+	{"Asia/Manila", func(lat, lng float64) bool {
+		return lat >= 4.5 && lat <= 21.5 && lng >= 116.9 && lng <= 127
+	}},
+	// Indonesia: WIT in Maluku and Papua, WITA from Bali and central Borneo to
+	// Sulawesi, WIB on Sumatra, Java and western Borneo
+	{"Asia/Jayapura", func(lat, lng float64) bool {
+		return lat >= -11 && lat <= 6 && lng >= 126 && lng <= 141
+	}},
+	{"Asia/Makassar", func(lat, lng float64) bool {
+		return lat >= -11 && lat <= 6 && lng >= 114.5 && lng < 126
+	}},
+	{"Asia/Jakarta", func(lat, lng float64) bool {
+		return lat >= -11 && lat <= 6 && lng >= 95 && lng < 114.5
+	}},
+}
+
+// ForCoordinates guesses the zone of a location. Outside the known regions it
+// falls back to the whole-hour offset of the longitude, which gets the local
+// time right at sea and in most places without daylight saving.
+func ForCoordinates(lat, lng float64) string {
+	for _, r := range regions {
+		if r.contains(lat, lng) {
+			return r.zone
+		}
+	}
+
+	offset := int(math.Round(lng / 15))
+	if offset > 12 {
+		offset = 12
+	}
+	if offset < -12 {
+		offset = -12
+	}
+	if offset == 0 {
+		return "UTC"
+	}
+	// Etc zones use POSIX signs: Etc/GMT-8 is eight hours ahead of UTC
+	return fmt.Sprintf("Etc/GMT%+d", -offset)
+}
+
+// WallClock interprets the date and clock time of t, ignoring its location, in loc
+func WallClock(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
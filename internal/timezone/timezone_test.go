@@ -0,0 +1,66 @@
+package timezone_test
+
+import (
+	"playspotter/internal/timezone"
+	"testing"
+	"time"
+)
+
+func TestForCoordinates(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lng float64
+		want     string
+	}{
+		{"Jakarta", -6.2, 106.85, "Asia/Jakarta"},
+		{"Medan", 3.59, 98.67, "Asia/Jakarta"},
+		{"Pontianak", -0.03, 109.33, "Asia/Jakarta"},
+		{"Denpasar", -8.65, 115.22, "Asia/Makassar"},
+		{"Makassar", -5.14, 119.41, "Asia/Makassar"},
+		{"Balikpapan", -1.27, 116.83, "Asia/Makassar"},
+		{"Ambon", -3.7, 128.18, "Asia/Jayapura"},
+		{"Jayapura", -2.53, 140.72, "Asia/Jayapura"},
+		{"Singapore", 1.35, 103.82, "Asia/Singapore"},
+		{"Kuala Lumpur", 3.14, 101.69, "Asia/Kuala_Lumpur"},
+		{"Dumai", 1.67, 101.45, "Asia/Jakarta"},
+		{"Kota Kinabalu", 5.98, 116.07, "Asia/Kuching"},
+		{"Dili", -8.56, 125.57, "Asia/Dili"},
+		{"Manila", 14.6, 120.98, "Asia/Manila"},
+		{"London", 51.5, -0.13, "UTC"},
+		{"Tokyo", 35.68, 139.69, "Etc/GMT-9"},
+		{"New York", 40.71, -74.0, "Etc/GMT+5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timezone.ForCoordinates(tt.lat, tt.lng)
+			if got != tt.want {
+				t.Errorf("ForCoordinates(%v, %v) = %q, want %q", tt.lat, tt.lng, got, tt.want)
+			}
+			if _, err := timezone.Load(got); err != nil {
+				t.Errorf("zone %q does not load: %v", got, err)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsServerZones(t *testing.T) {
+	for _, name := range []string{"", "Local", "Mars/Olympus"} {
+		if _, err := timezone.Load(name); err == nil {
+			t.Errorf("Load(%q) succeeded", name)
+		}
+	}
+}
+
+func TestWallClock(t *testing.T) {
+	loc, err := timezone.Load("Asia/Makassar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := timezone.WallClock(time.Date(2026, 3, 10, 19, 0, 0, 0, time.UTC), loc)
+	want := time.Date(2026, 3, 10, 11, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("WallClock = %v, want %v", got.UTC(), want)
+	}
+}
@@ -0,0 +1,65 @@
+-- Sports catalog
+CREATE TABLE sports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug VARCHAR(50) UNIQUE NOT NULL CHECK (slug ~ '^[a-z0-9]+(-[a-z0-9]+)*$'),
+    names JSONB NOT NULL DEFAULT '{}',
+    icon TEXT,
+    default_team_size INT CHECK (default_team_size >= 1),
+    aliases JSONB NOT NULL DEFAULT '[]',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TRIGGER update_sports_updated_at BEFORE UPDATE ON sports
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Seed the catalog with the sports we see most often
+INSERT INTO sports (slug, names, default_team_size, aliases) VALUES
+    ('futsal',       '{"en": "Futsal", "id": "Futsal"}',                 5,    '["futsal 5v5", "futsal 5 vs 5", "5v5 futsal"]'),
+    ('football',     '{"en": "Football", "id": "Sepak Bola"}',           11,   '["soccer", "sepakbola", "bola"]'),
+    ('mini-soccer',  '{"en": "Mini Soccer", "id": "Mini Soccer"}',       7,    '["minisoccer", "mini football", "7v7"]'),
+    ('basketball',   '{"en": "Basketball", "id": "Bola Basket"}',        5,    '["basket", "basketball 3x3", "3x3"]'),
+    ('volleyball',   '{"en": "Volleyball", "id": "Bola Voli"}',          6,    '["voli", "volley", "bola voli"]'),
+    ('badminton',    '{"en": "Badminton", "id": "Bulu Tangkis"}',        2,    '["bulutangkis", "bultang"]'),
+    ('tennis',       '{"en": "Tennis", "id": "Tenis"}',                  2,    '["tenis lapangan"]'),
+    ('table-tennis', '{"en": "Table Tennis", "id": "Tenis Meja"}',       2,    '["ping pong", "pingpong"]'),
+    ('padel',        '{"en": "Padel", "id": "Padel"}',                   2,    '["padel tennis"]'),
+    ('running',      '{"en": "Running", "id": "Lari"}',                  NULL, '["jogging", "run"]'),
+    ('cycling',      '{"en": "Cycling", "id": "Bersepeda"}',             NULL, '["gowes", "sepeda", "bike"]'),
+    ('swimming',     '{"en": "Swimming", "id": "Renang"}',               NULL, '["swim"]'),
+    ('other',        '{"en": "Other", "id": "Lainnya"}',                 NULL, '[]');
+
+-- Map existing free-text sport types onto catalog slugs (case, whitespace, names and aliases)
+UPDATE events e
+SET sport_type = s.slug
+FROM sports s
+WHERE lower(regexp_replace(btrim(e.sport_type), '\s+', ' ', 'g')) IN (s.slug, replace(s.slug, '-', ' '))
+   OR s.aliases ? lower(regexp_replace(btrim(e.sport_type), '\s+', ' ', 'g'))
+   OR EXISTS (
+        SELECT 1 FROM jsonb_each_text(s.names) n
+        WHERE lower(n.value) = lower(regexp_replace(btrim(e.sport_type), '\s+', ' ', 'g'))
+   );
+
+-- Anything left becomes its own catalog entry, keeping the original spelling as an alias
+INSERT INTO sports (slug, names, aliases)
+SELECT slug,
+       jsonb_build_object('en', min(original)),
+       jsonb_agg(DISTINCT lower(regexp_replace(original, '\s+', ' ', 'g')))
+FROM (
+    SELECT btrim(sport_type) AS original,
+           COALESCE(NULLIF(btrim(regexp_replace(lower(btrim(sport_type)), '[^a-z0-9]+', '-', 'g'), '-'), ''), 'other') AS slug
+    FROM events
+    WHERE sport_type NOT IN (SELECT slug FROM sports)
+) unmatched
+GROUP BY slug
+ON CONFLICT (slug) DO NOTHING;
+
+UPDATE events
+SET sport_type = COALESCE(NULLIF(btrim(regexp_replace(lower(btrim(sport_type)), '[^a-z0-9]+', '-', 'g'), '-'), ''), 'other')
+WHERE sport_type NOT IN (SELECT slug FROM sports);
+
+-- Events now reference the catalog; renaming a slug follows through to events
+ALTER TABLE events
+    ADD CONSTRAINT fk_events_sport_type FOREIGN KEY (sport_type)
+    REFERENCES sports(slug) ON UPDATE CASCADE ON DELETE RESTRICT;
@@ -0,0 +1,43 @@
+-- Venues table
+CREATE TABLE venues (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(160) NOT NULL,
+    address TEXT,
+    latitude DECIMAL(9,6) NOT NULL CHECK (latitude >= -90 AND latitude <= 90),
+    longitude DECIMAL(9,6) NOT NULL CHECK (longitude >= -180 AND longitude <= 180),
+    sports JSONB NOT NULL DEFAULT '[]',
+    amenities JSONB NOT NULL DEFAULT '[]',
+    opening_hours JSONB NOT NULL DEFAULT '{}',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_venues_location ON venues(latitude, longitude);
+CREATE INDEX idx_venues_name ON venues(lower(name));
+
+CREATE TRIGGER update_venues_updated_at BEFORE UPDATE ON venues
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Events can reference a venue; location columns stay as a copy for the feed query
+ALTER TABLE events ADD COLUMN venue_id UUID REFERENCES venues(id) ON DELETE SET NULL;
+CREATE INDEX idx_events_venue_id ON events(venue_id);
+
+-- Backfill venues from named event locations; near-identical ones can be merged by admins
+INSERT INTO venues (name, address, latitude, longitude, sports)
+SELECT min(btrim(location_name)),
+       min(address),
+       round(latitude, 4),
+       round(longitude, 4),
+       jsonb_agg(DISTINCT sport_type)
+FROM events
+WHERE location_name IS NOT NULL AND btrim(location_name) <> ''
+GROUP BY lower(btrim(location_name)), round(latitude, 4), round(longitude, 4);
+
+UPDATE events e
+SET venue_id = v.id
+FROM venues v
+WHERE e.location_name IS NOT NULL
+  AND lower(btrim(e.location_name)) = lower(v.name)
+  AND round(e.latitude, 4) = v.latitude
+  AND round(e.longitude, 4) = v.longitude;
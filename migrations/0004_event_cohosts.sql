@@ -0,0 +1,12 @@
+-- Event co-hosts table
+CREATE TABLE event_cohosts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    added_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(event_id, user_id)
+);
+
+CREATE INDEX idx_event_cohosts_event_id ON event_cohosts(event_id);
+CREATE INDEX idx_event_cohosts_user_id ON event_cohosts(user_id);
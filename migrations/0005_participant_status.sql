@@ -0,0 +1,8 @@
+-- Participant status so organizers can remove or ban users with a reason
+ALTER TABLE event_participants
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'joined' CHECK (status IN ('joined', 'removed', 'banned')),
+    ADD COLUMN removal_reason TEXT,
+    ADD COLUMN removed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN removed_at TIMESTAMPTZ;
+
+CREATE INDEX idx_event_participants_event_status ON event_participants(event_id, status);
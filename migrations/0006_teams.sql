@@ -0,0 +1,38 @@
+-- Self-assessed skill level per sport, used for team balancing
+CREATE TABLE user_sport_skills (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sport_type VARCHAR(50) NOT NULL REFERENCES sports(slug) ON UPDATE CASCADE ON DELETE CASCADE,
+    level INT NOT NULL CHECK (level BETWEEN 1 AND 5),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(user_id, sport_type)
+);
+
+CREATE TRIGGER update_user_sport_skills_updated_at BEFORE UPDATE ON user_sport_skills
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Generated teams for an event
+CREATE TABLE event_teams (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name VARCHAR(60) NOT NULL,
+    color VARCHAR(30) NOT NULL,
+    position INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(event_id, position)
+);
+
+CREATE INDEX idx_event_teams_event_id ON event_teams(event_id);
+
+-- Team members; leaving the event removes the player from their team
+CREATE TABLE event_team_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES event_teams(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    UNIQUE(event_id, user_id),
+    FOREIGN KEY (event_id, user_id) REFERENCES event_participants(event_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_event_team_members_team_id ON event_team_members(team_id);
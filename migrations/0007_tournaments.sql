@@ -0,0 +1,68 @@
+-- Tournaments run on top of an event, one per event
+CREATE TABLE tournaments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL UNIQUE REFERENCES events(id) ON DELETE CASCADE,
+    format TEXT NOT NULL CHECK (format IN ('single_elimination', 'round_robin')),
+    status TEXT NOT NULL DEFAULT 'registration' CHECK (status IN ('registration', 'in_progress', 'completed')),
+    max_teams INT NOT NULL CHECK (max_teams >= 2),
+    points_win INT NOT NULL DEFAULT 3,
+    points_draw INT NOT NULL DEFAULT 1,
+    points_loss INT NOT NULL DEFAULT 0,
+    winner_team_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TRIGGER update_tournaments_updated_at BEFORE UPDATE ON tournaments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Registered teams; seed is the registration order
+CREATE TABLE tournament_teams (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    name VARCHAR(60) NOT NULL,
+    captain_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    seed INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_tournament_teams_tournament_id ON tournament_teams(tournament_id);
+
+ALTER TABLE tournaments
+    ADD CONSTRAINT tournaments_winner_team_id_fkey FOREIGN KEY (winner_team_id) REFERENCES tournament_teams(id) ON DELETE SET NULL;
+
+-- A player can only be on one team per tournament
+CREATE TABLE tournament_team_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES tournament_teams(id) ON DELETE CASCADE,
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE(tournament_id, user_id)
+);
+
+CREATE INDEX idx_tournament_team_members_team_id ON tournament_team_members(team_id);
+
+-- Fixtures; knockout winners advance into next_match_id on the given side
+CREATE TABLE tournament_matches (
+    id UUID PRIMARY KEY,
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    round INT NOT NULL,
+    position INT NOT NULL,
+    home_team_id UUID REFERENCES tournament_teams(id) ON DELETE SET NULL,
+    away_team_id UUID REFERENCES tournament_teams(id) ON DELETE SET NULL,
+    home_score INT CHECK (home_score >= 0),
+    away_score INT CHECK (away_score >= 0),
+    winner_team_id UUID REFERENCES tournament_teams(id) ON DELETE SET NULL,
+    status TEXT NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'completed', 'bye')),
+    scheduled_at TIMESTAMPTZ,
+    next_match_id UUID REFERENCES tournament_matches(id) ON DELETE SET NULL,
+    next_slot TEXT CHECK (next_slot IN ('home', 'away')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(tournament_id, round, position)
+);
+
+CREATE INDEX idx_tournament_matches_tournament_id ON tournament_matches(tournament_id);
+
+CREATE TRIGGER update_tournament_matches_updated_at BEFORE UPDATE ON tournament_matches
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,44 @@
+-- Event pricing
+ALTER TABLE events
+    ADD COLUMN price_cents INT NOT NULL DEFAULT 0 CHECK (price_cents >= 0),
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+-- Paid events reserve a spot until the payment completes or times out
+ALTER TABLE event_participants DROP CONSTRAINT event_participants_status_check;
+ALTER TABLE event_participants
+    ADD CONSTRAINT event_participants_status_check CHECK (status IN ('joined', 'pending_payment', 'removed', 'banned')),
+    ADD COLUMN payment_expires_at TIMESTAMPTZ;
+
+CREATE TABLE payments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(30) NOT NULL,
+    provider_ref VARCHAR(255) NOT NULL,
+    amount_cents INT NOT NULL CHECK (amount_cents > 0),
+    currency VARCHAR(3) NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed', 'expired', 'refunded', 'refund_failed')),
+    checkout_url TEXT,
+    expires_at TIMESTAMPTZ NOT NULL,
+    paid_at TIMESTAMPTZ,
+    refunded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(provider, provider_ref)
+);
+
+CREATE INDEX idx_payments_event_status ON payments(event_id, status);
+CREATE INDEX idx_payments_user_id ON payments(user_id);
+
+CREATE TRIGGER update_payments_updated_at BEFORE UPDATE ON payments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Processed provider callbacks, so retried deliveries are applied once
+CREATE TABLE payment_webhook_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    provider VARCHAR(30) NOT NULL,
+    external_id VARCHAR(255) NOT NULL,
+    type VARCHAR(60) NOT NULL,
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(provider, external_id)
+);
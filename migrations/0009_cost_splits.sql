@@ -0,0 +1,35 @@
+-- Check-ins decide who shares the cost of an event
+ALTER TABLE event_participants ADD COLUMN checked_in_at TIMESTAMPTZ;
+
+-- Court cost entered by the organizer after the game
+CREATE TABLE event_cost_splits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL UNIQUE REFERENCES events(id) ON DELETE CASCADE,
+    total_cents INT NOT NULL CHECK (total_cents > 0),
+    currency VARCHAR(3) NOT NULL,
+    note TEXT,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TRIGGER update_event_cost_splits_updated_at BEFORE UPDATE ON event_cost_splits
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Each checked-in participant's share and whether it was settled
+CREATE TABLE event_cost_shares (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    split_id UUID NOT NULL REFERENCES event_cost_splits(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount_cents INT NOT NULL CHECK (amount_cents >= 0),
+    exempt BOOLEAN NOT NULL DEFAULT false,
+    settled_at TIMESTAMPTZ,
+    settled_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    last_reminded_at TIMESTAMPTZ,
+    reminder_count INT NOT NULL DEFAULT 0,
+    UNIQUE(split_id, user_id)
+);
+
+CREATE INDEX idx_event_cost_shares_event_id ON event_cost_shares(event_id);
+CREATE INDEX idx_event_cost_shares_user_outstanding ON event_cost_shares(user_id) WHERE settled_at IS NULL;
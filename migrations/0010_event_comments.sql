@@ -0,0 +1,30 @@
+-- Who can read an event's discussion
+ALTER TABLE events ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'participants'));
+
+-- Event discussion; replies point at a top-level comment
+CREATE TABLE event_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES event_comments(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    pinned BOOLEAN NOT NULL DEFAULT false,
+    pinned_at TIMESTAMPTZ,
+    pinned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    edited_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    deleted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    hidden_at TIMESTAMPTZ,
+    hidden_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    hidden_reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_event_comments_threads ON event_comments(event_id, pinned DESC, created_at DESC) WHERE parent_id IS NULL;
+CREATE INDEX idx_event_comments_parent_id ON event_comments(parent_id);
+CREATE INDEX idx_event_comments_hidden ON event_comments(hidden_at) WHERE hidden_at IS NOT NULL;
+
+CREATE TRIGGER update_event_comments_updated_at BEFORE UPDATE ON event_comments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
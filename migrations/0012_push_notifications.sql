@@ -0,0 +1,16 @@
+-- Push tokens of users' devices; a token belongs to the account that registered it last
+CREATE TABLE device_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    platform VARCHAR(10) NOT NULL CHECK (platform IN ('ios', 'android', 'web')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_device_tokens_user_id ON device_tokens(user_id);
+
+-- Whether a notification type is pushed; stored rows take the type's default
+ALTER TABLE notification_preferences ADD COLUMN push BOOLEAN NOT NULL DEFAULT false;
+UPDATE notification_preferences SET push = true
+    WHERE type IN ('event_cancelled', 'event_rescheduled', 'cost_reminder');
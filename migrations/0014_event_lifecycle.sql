@@ -0,0 +1,16 @@
+-- How long an event lasts, so the lifecycle job knows when it is over
+ALTER TABLE events ADD COLUMN duration_minutes INT NOT NULL DEFAULT 120
+    CHECK (duration_minutes BETWEEN 15 AND 1440);
+
+-- Drafts, running and finished events
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_status_check;
+ALTER TABLE events ADD CONSTRAINT events_status_check
+    CHECK (status IN ('draft', 'open', 'full', 'ongoing', 'completed', 'cancelled'));
+
+-- Past events that never moved on
+UPDATE events SET status = 'completed'
+    WHERE status IN ('open', 'full') AND event_time + duration_minutes * interval '1 minute' <= now();
+UPDATE events SET status = 'ongoing'
+    WHERE status IN ('open', 'full') AND event_time <= now();
+
+CREATE INDEX idx_events_status_event_time ON events(status, event_time);
@@ -0,0 +1,7 @@
+-- Drafts can be published by hand or at a scheduled time
+ALTER TABLE events ADD COLUMN publish_at TIMESTAMPTZ;
+ALTER TABLE events ADD COLUMN published_at TIMESTAMPTZ;
+
+UPDATE events SET published_at = created_at WHERE status <> 'draft';
+
+CREATE INDEX idx_events_drafts ON events(creator_id) WHERE status = 'draft';
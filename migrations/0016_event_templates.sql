@@ -0,0 +1,26 @@
+-- Saved event details organizers reuse for recurring events
+CREATE TABLE event_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(80) NOT NULL,
+    title VARCHAR(120) NOT NULL,
+    sport_type VARCHAR(50) NOT NULL REFERENCES sports(slug) ON UPDATE CASCADE,
+    venue_id UUID REFERENCES venues(id) ON DELETE SET NULL,
+    location_name VARCHAR(160),
+    address TEXT,
+    latitude DECIMAL(9,6) NOT NULL,
+    longitude DECIMAL(9,6) NOT NULL,
+    capacity INT NOT NULL CHECK (capacity >= 1),
+    description TEXT,
+    price_cents INT NOT NULL DEFAULT 0 CHECK (price_cents >= 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    visibility TEXT NOT NULL DEFAULT 'public' CHECK (visibility IN ('public', 'participants')),
+    duration_minutes INT NOT NULL DEFAULT 120 CHECK (duration_minutes BETWEEN 15 AND 1440),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_event_templates_user_id ON event_templates(user_id);
+
+CREATE TRIGGER update_event_templates_updated_at BEFORE UPDATE ON event_templates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,14 @@
+-- IANA time zone each event takes place in; start times stay stored in UTC
+ALTER TABLE events ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE event_templates ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+-- Existing events in Indonesia get their zone from roughly the same bands new events use;
+-- everything else keeps UTC until its organizer sets a zone
+UPDATE events SET time_zone = CASE
+        WHEN longitude >= 126 THEN 'Asia/Jayapura'
+        WHEN longitude >= 114.5 THEN 'Asia/Makassar'
+        ELSE 'Asia/Jakarta'
+    END
+    WHERE latitude BETWEEN -11 AND 6 AND longitude BETWEEN 95 AND 141
+        AND NOT (latitude > 1.2 AND longitude BETWEEN 99.6 AND 104.6)
+        AND NOT (latitude > 1 AND longitude BETWEEN 109.5 AND 119.3);
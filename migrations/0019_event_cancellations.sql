@@ -0,0 +1,7 @@
+-- Cancellations record why, when and by whom an event was cancelled
+ALTER TABLE events ADD COLUMN cancellation_reason VARCHAR(500);
+ALTER TABLE events ADD COLUMN cancelled_at TIMESTAMPTZ;
+ALTER TABLE events ADD COLUMN cancelled_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Events cancelled before this was recorded keep their last update as the time
+UPDATE events SET cancelled_at = updated_at WHERE status = 'cancelled';
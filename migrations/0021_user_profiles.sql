@@ -0,0 +1,9 @@
+-- Public profile details and the privacy settings that control who sees them
+ALTER TABLE users ADD COLUMN avatar_url VARCHAR(500);
+ALTER TABLE users ADD COLUMN bio VARCHAR(500);
+ALTER TABLE users ADD COLUMN favorite_sports JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE users ADD COLUMN home_area VARCHAR(120);
+ALTER TABLE users ADD COLUMN profile_visibility TEXT NOT NULL DEFAULT 'public'
+    CHECK (profile_visibility IN ('public', 'followers', 'private'));
+ALTER TABLE users ADD COLUMN show_home_area BOOLEAN NOT NULL DEFAULT true;
+ALTER TABLE users ADD COLUMN show_stats BOOLEAN NOT NULL DEFAULT true;
@@ -0,0 +1,33 @@
+-- Users can block others; blocks hide events from the feed both ways and stop joins
+CREATE TABLE user_blocks (
+    blocker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX idx_user_blocks_blocked ON user_blocks(blocked_id);
+
+-- Reports about users, events and comments wait in the admin moderation queue
+CREATE TABLE reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_type VARCHAR(10) NOT NULL CHECK (target_type IN ('user', 'event', 'comment')),
+    target_id UUID NOT NULL,
+    category VARCHAR(20) NOT NULL
+        CHECK (category IN ('spam', 'harassment', 'hate', 'violence', 'inappropriate', 'scam', 'other')),
+    details VARCHAR(1000),
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_reports_queue ON reports(status, created_at);
+CREATE INDEX idx_reports_target ON reports(target_type, target_id);
+
+-- A reporter has at most one open report per target
+CREATE UNIQUE INDEX idx_reports_open_per_reporter ON reports(reporter_id, target_type, target_id) WHERE status = 'open';
+
+CREATE TRIGGER update_reports_updated_at BEFORE UPDATE ON reports
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,41 @@
+-- Reports are claimed by an admin and closed as resolved or dismissed
+ALTER TABLE reports ADD CONSTRAINT reports_status_check
+    CHECK (status IN ('open', 'claimed', 'resolved', 'dismissed'));
+ALTER TABLE reports ADD COLUMN claimed_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE reports ADD COLUMN claimed_at TIMESTAMPTZ;
+ALTER TABLE reports ADD COLUMN resolved_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE reports ADD COLUMN resolved_at TIMESTAMPTZ;
+ALTER TABLE reports ADD COLUMN resolution VARCHAR(20);
+
+-- Claimed reports still count as waiting for review
+DROP INDEX idx_reports_open_per_reporter;
+CREATE UNIQUE INDEX idx_reports_open_per_reporter ON reports(reporter_id, target_type, target_id)
+    WHERE status IN ('open', 'claimed');
+
+-- Events hidden by moderators are kept out of the feed
+ALTER TABLE events ADD COLUMN hidden_at TIMESTAMPTZ;
+ALTER TABLE events ADD COLUMN hidden_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE events ADD COLUMN hidden_reason VARCHAR(500);
+
+-- Suspensions handed out by moderators
+ALTER TABLE users ADD COLUMN suspended_until TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN suspension_reason VARCHAR(500);
+
+-- Every moderation action and the admin who took it; rows are never updated
+CREATE TABLE moderation_actions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    report_id UUID REFERENCES reports(id) ON DELETE SET NULL,
+    admin_id UUID NOT NULL REFERENCES users(id),
+    action VARCHAR(20) NOT NULL
+        CHECK (action IN ('warn', 'hide_event', 'unhide_event', 'hide_comment', 'suspend_user', 'dismiss')),
+    target_type VARCHAR(10) NOT NULL,
+    target_id UUID NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    note VARCHAR(1000),
+    suspended_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_moderation_actions_created ON moderation_actions(created_at DESC);
+CREATE INDEX idx_moderation_actions_target ON moderation_actions(target_type, target_id);
+CREATE INDEX idx_moderation_actions_user ON moderation_actions(user_id);
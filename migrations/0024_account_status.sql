@@ -0,0 +1,14 @@
+-- Account status: suspended users are locked out until suspended_until, banned
+-- users until an admin lifts the ban
+ALTER TABLE users ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'suspended', 'banned'));
+ALTER TABLE users RENAME COLUMN suspension_reason TO status_reason;
+ALTER TABLE users ADD COLUMN status_changed_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN status_changed_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+UPDATE users SET status = 'suspended' WHERE suspended_until > now();
+
+-- Bans and lifted restrictions are moderation actions too
+ALTER TABLE moderation_actions DROP CONSTRAINT moderation_actions_action_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_check
+    CHECK (action IN ('warn', 'hide_event', 'unhide_event', 'hide_comment', 'suspend_user', 'ban_user', 'unban_user', 'dismiss'));
@@ -0,0 +1,2 @@
+-- Completed jobs are purged once they are older than JOB_RETENTION
+CREATE INDEX idx_jobs_completed ON jobs(completed_at) WHERE status = 'done';